@@ -1,6 +1,7 @@
 package kinvest
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -10,7 +11,7 @@ import (
 	"github.com/goccy/go-yaml"
 )
 
-var defaultAccessTokenPath string
+var defaultAccessTokenPath, defaultVtsAccessTokenPath string
 
 func init() {
 	wd, err := os.Getwd()
@@ -18,16 +19,18 @@ func init() {
 		panic(fmt.Errorf("failed to get current working directory: %w", err))
 	}
 	defaultAccessTokenPath = path.Join(wd, "kinvest_access_token.yaml")
+	defaultVtsAccessTokenPath = path.Join(wd, "kinvest_vts_access_token.yaml")
 }
 
-type accessToken struct {
+// Token is the access token issued by /oauth2/tokenP.
+type Token struct {
 	TokenType   string    `json:"token_type" yaml:"token_type"`
 	AccessToken string    `json:"access_token" yaml:"access_token"`
 	ExpiresIn   time.Time `json:"expires_in" yaml:"expires_in"`
 }
 
-func loadAccessToken(tokenPath string) (*accessToken, error) {
-	ret := &accessToken{}
+func loadToken(tokenPath string) (*Token, error) {
+	ret := &Token{}
 	f, err := os.Open(tokenPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open token file: %w", err)
@@ -47,40 +50,39 @@ func loadAccessToken(tokenPath string) (*accessToken, error) {
 		return nil, fmt.Errorf("unsupported file extension: %s", ext)
 	}
 
-	if ret.TokenType == "" || ret.AccessToken == "" || ret.ExpiresIn.IsZero() {
+	if !ret.valid() {
 		return nil, fmt.Errorf("invalid token data")
 	}
-	if ret.IsExpired() {
-		return nil, fmt.Errorf("token expired")
-	}
 
 	return ret, nil
 }
 
-func (t *accessToken) Save(tokenPath string) error {
-	f, err := os.Create(tokenPath)
-	if err != nil {
-		return fmt.Errorf("failed to create token file: %w", err)
-	}
-	defer f.Close()
-
+// Save saves the token to the file. The format follows the extension, json or yaml.
+// The file is replaced atomically, so readers never see a partially written token.
+func (t *Token) Save(tokenPath string) error {
+	buf := bytes.NewBuffer(nil)
 	switch ext := getExt(tokenPath); ext {
 	case "json":
-		if err := json.NewEncoder(f).Encode(t); err != nil {
+		if err := json.NewEncoder(buf).Encode(t); err != nil {
 			return fmt.Errorf("failed to save token: %w", err)
 		}
 	case "yaml", "yml":
-		if err := yaml.NewEncoder(f).Encode(t); err != nil {
+		if err := yaml.NewEncoder(buf).Encode(t); err != nil {
 			return fmt.Errorf("failed to save token: %w", err)
 		}
 	default:
 		return fmt.Errorf("unsupported file extension: %s", ext)
 	}
 
+	if err := writeFileAtomic(tokenPath, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+
 	return nil
 }
 
-func (t *accessToken) IsExpired() bool {
+// IsExpired reports whether the token is expired or will expire within 1 minute.
+func (t *Token) IsExpired() bool {
 	expiresIn := t.ExpiresIn
 	if expiresIn.IsZero() {
 		return true
@@ -92,7 +94,8 @@ func (t *accessToken) IsExpired() bool {
 	return expiresIn.Before(time.Now())
 }
 
-func (t *accessToken) Authorization() string {
+// Authorization returns the value for the authorization header.
+func (t *Token) Authorization() string {
 	ret := t.TokenType + " " + t.AccessToken
 	if ret == " " {
 		return ""
@@ -100,3 +103,7 @@ func (t *accessToken) Authorization() string {
 
 	return ret
 }
+
+func (t *Token) valid() bool {
+	return t != nil && t.TokenType != "" && t.AccessToken != "" && !t.ExpiresIn.IsZero()
+}
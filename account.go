@@ -0,0 +1,147 @@
+package kinvest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Account is the KIS account number, XXXXXXXX-XX.
+type Account struct {
+	CANO        string      // 종합계좌번호, 계좌번호 앞 8자리
+	ProductCode ProductCode // 계좌상품코드, 계좌번호 뒤 2자리
+}
+
+// ParseAccount parses the account number in XXXXXXXX-XX format.
+func ParseAccount(s string) (Account, error) {
+	cano, code, ok := strings.Cut(s, "-")
+	a := Account{CANO: cano, ProductCode: ProductCode(code)}
+	if !ok {
+		return Account{}, fmt.Errorf("invalid account format: %s", s)
+	}
+	if err := a.validate(); err != nil {
+		return Account{}, err
+	}
+	return a, nil
+}
+
+// String returns the account number in XXXXXXXX-XX format.
+func (a Account) String() string {
+	return a.CANO + "-" + string(a.ProductCode)
+}
+
+// MarshalYAML marshals the account in XXXXXXXX-XX format.
+func (a Account) MarshalYAML() (any, error) {
+	return a.String(), nil
+}
+
+func (a Account) validate() error {
+	if len(a.CANO) != 8 || strings.Trim(a.CANO, "0123456789") != "" {
+		return fmt.Errorf("invalid account format: %s", a)
+	}
+	if !a.ProductCode.valid() {
+		return fmt.Errorf("invalid account product code: %s, set one of the following: %s", string(a.ProductCode), joinNames(productCodes))
+	}
+	return nil
+}
+
+// ProductCode is the account product code (계좌상품코드).
+type ProductCode string
+
+const (
+	ProductStock             ProductCode = "01" // 종합계좌
+	ProductFutures           ProductCode = "03" // 국내선물옵션
+	ProductOverseasFutures   ProductCode = "08" // 해외선물옵션
+	ProductPension           ProductCode = "22" // 개인연금
+	ProductRetirementPension ProductCode = "29" // 퇴직연금
+)
+
+var productCodes = []ProductCode{ProductStock, ProductFutures, ProductOverseasFutures, ProductPension, ProductRetirementPension}
+
+var productCodeNames = map[ProductCode]string{
+	ProductStock:             "종합계좌",
+	ProductFutures:           "국내선물옵션",
+	ProductOverseasFutures:   "해외선물옵션",
+	ProductPension:           "개인연금",
+	ProductRetirementPension: "퇴직연금",
+}
+
+// 국내주식 주문, 조회는 종합계좌와 연금계좌, 신용과 예약주문은 종합계좌만 쓸 수 있다
+var (
+	stockProducts  = []ProductCode{ProductStock, ProductPension, ProductRetirementPension}
+	creditProducts = []ProductCode{ProductStock}
+)
+
+// String returns the Korean name of the product code.
+func (p ProductCode) String() string {
+	if name, ok := productCodeNames[p]; ok {
+		return name
+	}
+	return string(p)
+}
+
+// MarshalYAML marshals the product code in its Korean name.
+func (p ProductCode) MarshalYAML() (any, error) {
+	return p.String(), nil
+}
+
+func (p ProductCode) valid() bool {
+	_, ok := productCodeNames[p]
+	return ok
+}
+
+// accountParams returns CANO and ACNT_PRDT_CD of the client's account
+// if the product of the account is one of allowed.
+func (c *Client) accountParams(allowed []ProductCode) (*string, *int, error) {
+	if err := c.account.validate(); err != nil {
+		return nil, nil, err
+	}
+	if !slices.Contains(allowed, c.account.ProductCode) {
+		return nil, nil, fmt.Errorf("%s account %s is not supported, use one of the following: %s", c.account.ProductCode, c.account, joinNames(allowed))
+	}
+	return parseAccount(c.account.String())
+}
+
+// Account returns the account of the client.
+func (c *Client) Account() Account {
+	return c.account
+}
+
+// AccountClient is a Client for another account under the same app key.
+// It shares the access token, the rate limit and the HTTP client with the parent Client.
+// Note that Close and RevokeToken of it revoke the shared token.
+type AccountClient struct {
+	*Client
+}
+
+// ForAccount returns the AccountClient which calls the APIs with acct.
+// The calls to the APIs which don't support the product of acct fail without sending requests.
+func (c *Client) ForAccount(acct Account) *AccountClient {
+	root := c.root()
+	return &AccountClient{
+		Client: &Client{
+			oc:         root.oc,
+			httpClient: root.httpClient,
+			ip:         root.ip,
+			mac:        root.mac,
+			account:    acct,
+			env:        root.env,
+			custType:   root.custType,
+			corp:       root.corp,
+			hashkey:    root.hashkey,
+			appKey:     root.appKey,
+			appSecret:  root.appSecret,
+			tokenStore: root.tokenStore,
+			keepToken:  root.keepToken,
+			parent:     root,
+		},
+	}
+}
+
+// root returns the Client which owns the token, or c itself.
+func (c *Client) root() *Client {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
+}
@@ -0,0 +1,66 @@
+package kinvest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAccount(t *testing.T) {
+	a, err := ParseAccount("12345678-22")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12345678", a.CANO)
+		assert.Equal(t, ProductPension, a.ProductCode)
+		assert.Equal(t, "12345678-22", a.String())
+	}
+
+	for _, s := range []string{"", "12345678", "1234567-01", "1234567a-01", "12345678-1", "12345678-99"} {
+		_, err := ParseAccount(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestClientForAccount(t *testing.T) {
+	var tokenCnt, orderCnt atomic.Int32
+	var accounts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			tokenCnt.Add(1)
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-psbl-sell":
+			accounts = append(accounts, r.URL.Query().Get("CANO")+"-"+r.URL.Query().Get("ACNT_PRDT_CD"))
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0510","msg1":"조회가 완료되었습니다","output1":{"pdno":"005930","ord_psbl_qty":"10"}}`))
+		default:
+			orderCnt.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	pension := c.ForAccount(Account{CANO: "87654321", ProductCode: ProductPension})
+	assert.Equal(t, "87654321-22", pension.Account().String())
+
+	_, err := c.GetSellableQty(ctx, "005930")
+	assert.NoError(t, err)
+	_, err = pension.GetSellableQty(ctx, "005930")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"12345678-01", "87654321-22"}, accounts)
+	assert.Equal(t, int32(1), tokenCnt.Load(), "token should be shared")
+
+	// 연금계좌는 신용주문을 할 수 없고, 선물옵션계좌는 주식주문을 할 수 없다
+	_, err = pension.BuyDomesticStockOnCredit(ctx, "005930", 1, &CreditOrderOptions{CreditType: CreditTypeSelfLoanNew}, nil)
+	assert.ErrorContains(t, err, "개인연금 account 87654321-22 is not supported")
+
+	futures := pension.ForAccount(Account{CANO: "87654321", ProductCode: ProductFutures})
+	_, err = futures.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), orderCnt.Load())
+}
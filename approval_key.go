@@ -0,0 +1,44 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// GetApprovalKey issues a new websocket approval key (웹소켓 접속키) for the realtime feeds.
+func (c *Client) GetApprovalKey(ctx context.Context) (string, error) {
+	resp, err := c.oc.PostOauth2Approval(
+		ctx,
+		&oapi.PostOauth2ApprovalParams{},
+		oapi.PostOauth2ApprovalJSONRequestBody{
+			"grant_type": "client_credentials",
+			"appkey":     c.appKey,
+			"secretkey":  c.appSecret,
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errorFromResponse(resp)
+	}
+
+	respData := &oauth2ApprovalResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return "", fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if respData.ApprovalKey == "" {
+		return "", fmt.Errorf("no approval key in response")
+	}
+
+	return respData.ApprovalKey, nil
+}
+
+type oauth2ApprovalResponse struct {
+	ApprovalKey string `json:"approval_key"`
+}
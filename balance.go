@@ -10,8 +10,13 @@ import (
 )
 
 // GetDomesticAccountBalance retrieves the balance of the domestic account
+// It is not supported in VTS.
 func (c *Client) GetDomesticAccountBalance(ctx context.Context) (*DomesticAccountBalance, error) {
-	cano, acntprdtcd, err := parseAccount(c.account)
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("get domestic account balance is not supported in %s", c.env)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
 	if err != nil {
 		return nil, fmt.Errorf("parse account failed: %w", err)
 	}
@@ -23,7 +28,7 @@ func (c *Client) GetDomesticAccountBalance(ctx context.Context) (*DomesticAccoun
 			ACNTPRDTCD:     acntprdtcd,
 			INQRDVSN1:      ptr(""),
 			BSPRBFDTAPLYYN: ptr(""),
-			TrId:           ptr("CTRP6548R"),
+			TrId:           c.trID("CTRP6548R"),
 		},
 	)
 	if err != nil {
@@ -35,6 +40,9 @@ func (c *Client) GetDomesticAccountBalance(ctx context.Context) (*DomesticAccoun
 	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
+	if respData.RtCd != "0" {
+		return nil, newAPIError(resp, respData.RtCd, respData.MsgCd, respData.Msg1)
+	}
 
 	return NewDomesticAccountBalance(respData)
 }
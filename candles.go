@@ -0,0 +1,164 @@
+// 국내주식 > 기본시세 > 국내주식기간별시세(일/주/월/년)
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// Period is the period of a candle.
+type Period string
+
+const (
+	PeriodDay   Period = "D" // 일봉
+	PeriodWeek  Period = "W" // 주봉
+	PeriodMonth Period = "M" // 월봉
+	PeriodYear  Period = "Y" // 년봉
+)
+
+// Candle represents an OHLCV bar.
+type Candle struct {
+	Time       time.Time `yaml:"일시"` // 봉의 시작 시각
+	Open       int64     `yaml:"시가"`
+	High       int64     `yaml:"고가"`
+	Low        int64     `yaml:"저가"`
+	Close      int64     `yaml:"종가"`
+	Volume     int64     `yaml:"거래량"`
+	TradeValue int64     `yaml:"거래대금"`
+}
+
+// GetDomesticCandles retrieves the candles of the stock between from and to, in time order.
+// If adjusted is true, the prices are adjusted for the splits and the rights offerings (수정주가).
+// KIS gives at most 100 candles per request, so it requests repeatedly going backwards from to.
+func (c *Client) GetDomesticCandles(ctx context.Context, code string, period Period, from, to time.Time, adjusted bool) ([]*Candle, error) {
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+
+	switch period {
+	case PeriodDay, PeriodWeek, PeriodMonth, PeriodYear:
+	default:
+		return nil, fmt.Errorf("invalid period: %s", period)
+	}
+
+	// 봉은 일자 단위이므로 시각은 버린다
+	from, to = truncateDate(from), truncateDate(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid period: %s ~ %s", from.Format("20060102"), to.Format("20060102"))
+	}
+	fromDate := from.Format("20060102")
+
+	orgAdjPrc := 1 // 0: 수정주가, 1: 원주가
+	if adjusted {
+		orgAdjPrc = 0
+	}
+
+	var ret []*Candle
+	end := to
+	for {
+		resp, err := c.oc.GetUapiDomesticStockV1QuotationsInquireDailyItemchartprice(
+			ctx,
+			&oapi.GetUapiDomesticStockV1QuotationsInquireDailyItemchartpriceParams{
+				FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
+				FidInputDate1:      ptr(toInt(fromDate)),
+				FidInputDate2:      ptr(toInt(end.Format("20060102"))),
+				FidPeriodDivCode:   ptr(string(period)),
+				FidOrgAdjPrc:       ptr(orgAdjPrc),
+				TrId:               c.trID("FHKST03010100"),
+			},
+			withQuery("fid_input_iscd", code),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		respData := &uapiDomesticStockV1QuotationsInquireDailyItemchartpriceResponse{}
+		err = unmarshalJsonBody(resp.Body, respData)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal response failed: %w", err)
+		}
+
+		candles, err := validateDomesticCandles(resp, respData)
+		if err != nil {
+			return nil, err
+		}
+		// 최근 일자부터 내려온다
+		for _, candle := range candles {
+			if candle.Time.Before(from) || candle.Time.After(to) {
+				continue
+			}
+			ret = append(ret, candle)
+		}
+
+		if len(candles) == 0 {
+			break
+		}
+		oldest := candles[len(candles)-1].Time
+		if !oldest.After(from) {
+			break
+		}
+		// 다음 호출은 가장 과거 일자의 하루 전까지
+		next := oldest.AddDate(0, 0, -1)
+		if !next.Before(end) {
+			break
+		}
+		end = next
+	}
+
+	slices.Reverse(ret)
+	return ret, nil
+}
+
+type uapiDomesticStockV1QuotationsInquireDailyItemchartpriceResponse struct {
+	Output2 []*dailyItemchartpriceOutput `json:"output2"`
+	RtCd    string                       `json:"rt_cd"`
+	MsgCd   string                       `json:"msg_cd"`
+	Msg1    string                       `json:"msg1"`
+}
+
+type dailyItemchartpriceOutput struct {
+	StckBsopDate string `json:"stck_bsop_date"` // 주식영업일자
+	StckClpr     string `json:"stck_clpr"`      // 주식종가
+	StckOprc     string `json:"stck_oprc"`      // 주식시가
+	StckHgpr     string `json:"stck_hgpr"`      // 주식최고가
+	StckLwpr     string `json:"stck_lwpr"`      // 주식최저가
+	AcmlVol      string `json:"acml_vol"`       // 누적거래량
+	AcmlTrPbmn   string `json:"acml_tr_pbmn"`   // 누적거래대금
+}
+
+func validateDomesticCandles(resp *http.Response, data *uapiDomesticStockV1QuotationsInquireDailyItemchartpriceResponse) ([]*Candle, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	var ret []*Candle
+	for _, o := range data.Output2 {
+		// 데이터가 없는 구간은 빈 값으로 채워서 온다
+		if o == nil || o.StckBsopDate == "" {
+			continue
+		}
+		t, err := conv.YmdHmsToTime(o.StckBsopDate, "000000")
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %s", o.StckBsopDate)
+		}
+		ret = append(ret, &Candle{
+			Time:       t,
+			Open:       toInt64(o.StckOprc),
+			High:       toInt64(o.StckHgpr),
+			Low:        toInt64(o.StckLwpr),
+			Close:      toInt64(o.StckClpr),
+			Volume:     toInt64(o.AcmlVol),
+			TradeValue: toInt64(o.AcmlTrPbmn),
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,63 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomesticCandles(t *testing.T) {
+	// 20240101 ~ 20240110 의 일봉을 한 번에 최대 3개씩, 최근 일자부터 준다
+	var days []string
+	for d := 1; d <= 10; d++ {
+		days = append(days, fmt.Sprintf("202401%02d", d))
+	}
+
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-daily-itemchartprice":
+			calls++
+			q := r.URL.Query()
+			assert.Equal(t, "005930", q.Get("fid_input_iscd"))
+			from, end := q.Get("fid_input_date_1"), q.Get("fid_input_date_2")
+
+			var rows []string
+			for i := len(days) - 1; i >= 0 && len(rows) < 3; i-- {
+				if days[i] < from || days[i] > end {
+					continue
+				}
+				rows = append(rows, fmt.Sprintf(`{"stck_bsop_date":"%s","stck_oprc":"100","stck_hgpr":"120","stck_lwpr":"90","stck_clpr":"110","acml_vol":"1000","acml_tr_pbmn":"110000"}`, days[i]))
+			}
+			fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output2":[%s]}`, strings.Join(rows, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, loc)
+	to := time.Date(2024, 1, 9, 15, 30, 0, 0, loc)
+	candles, err := c.GetDomesticCandles(context.Background(), "005930", PeriodDay, from, to, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, 3, calls)
+	if assert.Len(t, candles, 8) {
+		for i, candle := range candles {
+			assert.Equal(t, time.Date(2024, 1, 2+i, 0, 0, 0, 0, loc), candle.Time)
+		}
+		assert.Equal(t, int64(110), candles[0].Close)
+		assert.Equal(t, int64(110000), candles[0].TradeValue)
+	}
+}
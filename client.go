@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
@@ -15,9 +16,52 @@ import (
 
 // ClientConfig holds the configuration for the Kinvest client
 type ClientConfig struct {
-	AppKey    string
-	AppSecret string
-	Account   string // 계좌번호 XXXXXXXX-XX
+	AppKey      string
+	AppSecret   string
+	Account     string      // 계좌번호 XXXXXXXX-XX
+	Environment Environment // 실전투자(prod) 또는 모의투자(vts), 기본값 prod
+
+	// RateLimit is the maximum requests per second sent to the server.
+	// If it is 0, 20 for prod and 2 for vts are used. Negative value disables the limit.
+	RateLimit float64
+
+	// Retry is the retry policy for GET requests. If it is nil, DefaultRetryPolicy is used.
+	// Set MaxAttempts to 1 to disable the retry.
+	Retry *RetryPolicy
+
+	// TokenStore persists the access token. If it is nil, the token is saved in
+	// KINVEST_TOKEN_PATH or ./kinvest_access_token.yaml (./kinvest_vts_access_token.yaml for vts).
+	TokenStore TokenStore
+
+	// KeepTokenOnClose makes Close keep the token valid in the TokenStore.
+	// Set it when the TokenStore is shared with other processes, which would lose the token otherwise.
+	KeepTokenOnClose bool
+
+	// HTTPClient sends the requests. If it is nil, a new http.Client is used.
+	// Set it to use a proxy or a custom transport.
+	HTTPClient HttpRequestDoer
+
+	// BaseURL is the server URL, such as the URL of httptest.Server.
+	// If it is empty, the URL of the Environment is used.
+	BaseURL string
+
+	// Timeout is the default timeout of each request whose context has no deadline.
+	// If it is 0, DefaultTimeout is used. Negative value disables the timeout.
+	Timeout time.Duration
+
+	// CustType is the customer type, CustTypePersonal(기본값) or CustTypeBusiness.
+	// Corporate should be set for CustTypeBusiness.
+	CustType  CustType
+	Corporate *CorporateConfig
+
+	// Hashkey sets the hashkey header to the order requests.
+	// It costs one more request per order.
+	Hashkey bool
+}
+
+// HttpRequestDoer performs HTTP requests. *http.Client implements it.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
 // NewClientConfigFromEnv creates a new ClientConfig from environment variables
@@ -28,37 +72,53 @@ func NewClientConfigFromEnv() (*ClientConfig, error) {
 	if appKey == "" || appSecret == "" || account == "" {
 		return nil, fmt.Errorf("set KINVEST_APPKEY, KINVEST_APPSECRET, KINVEST_ACCOUNT env vars")
 	}
+	env, err := parseEnvironment(apiEnvs["ENV"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid KINVEST_ENV: %w", err)
+	}
 	return &ClientConfig{
-		AppKey:    appKey,
-		AppSecret: appSecret,
-		Account:   account,
+		AppKey:      appKey,
+		AppSecret:   appSecret,
+		Account:     account,
+		Environment: env,
 	}, nil
 }
 
 // Client is the main client for the Kinvest API
 type Client struct {
-	oc *oapi.Client
+	oc         *oapi.Client
+	httpClient HttpRequestDoer
 
-	ip      string
-	mac     string
-	account string
+	ip       string
+	mac      string
+	account  Account
+	env      Environment
+	custType CustType
+	corp     *CorporateConfig
+	hashkey  bool
 
-	appKey    string
-	appSecret string
-	token     *accessToken
+	appKey     string
+	appSecret  string
+	tokenStore TokenStore
+	keepToken  bool // Close 에서 토큰을 폐기하지 않는다
+
+	tokenMu   sync.Mutex
+	token     *Token
+	tokenCall *tokenCall
+
+	priceLimits sync.Map // 종목코드 -> *priceLimit, 주문 검증용 상하한가
+
+	parent *Client // ForAccount 로 만든 경우 토큰을 가진 Client
 }
 
 // NewClient creates a new Kinvest client
 // It uses the provided config to set up the client
 // If the config is nil, it will use the environment variables
-// KINVEST_APPKEY, KINVEST_APPSECRET, KINVEST_ACCOUNT
+// KINVEST_APPKEY, KINVEST_APPSECRET, KINVEST_ACCOUNT,
+// KINVEST_ENV (optional, prod or vts)
 // and KINVEST_TOKEN_PATH (optional) to save the access token
 func NewClient(config *ClientConfig) (*Client, error) {
-	ip, mac, err := getLocalIPAndMAC()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get local IP and MAC: %w", err)
-	}
-
+	var err error
 	if config == nil {
 		config, err = NewClientConfigFromEnv()
 		if err != nil {
@@ -69,9 +129,8 @@ func NewClient(config *ClientConfig) (*Client, error) {
 	c := &Client{
 		appKey:    config.AppKey,
 		appSecret: config.AppSecret,
-		account:   config.Account,
-		ip:        ip,
-		mac:       mac,
+		hashkey:   config.Hashkey,
+		keepToken: config.KeepTokenOnClose,
 	}
 	if c.appKey == "" {
 		c.appKey = apiEnvs["APPKEY"]
@@ -79,22 +138,63 @@ func NewClient(config *ClientConfig) (*Client, error) {
 	if c.appSecret == "" {
 		c.appSecret = apiEnvs["APPSECRET"]
 	}
-	if c.account == "" {
-		c.account = apiEnvs["ACCOUNT"]
+	account := config.Account
+	if account == "" {
+		account = apiEnvs["ACCOUNT"]
 	}
-	if c.appKey == "" || c.appSecret == "" || c.account == "" {
+	if c.appKey == "" || c.appSecret == "" || account == "" {
 		return nil, fmt.Errorf("invalid config: appKey, appSecret, account must be set")
 	}
+	c.account, err = ParseAccount(account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	c.env, err = parseEnvironment(string(config.Environment))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	c.custType, err = parseCustType(string(config.CustType))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if c.custType == CustTypeBusiness {
+		if err := c.setCorporate(config.Corporate); err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+	}
+	c.tokenStore = config.TokenStore
+	if c.tokenStore == nil {
+		c.tokenStore = NewFileTokenStore(c.tokenPath())
+	}
+
+	c.httpClient = config.HTTPClient
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{}
+	}
+	server := config.BaseURL
+	if server == "" {
+		server = c.env.addr()
+	}
+	doer := newTimeoutDoer(c.httpClient, config.Timeout)
+	doer = newRetryDoer(newRateLimitedDoer(doer, c.env, config.RateLimit), config.Retry)
+	if err := c.initOapiClient(server, doer); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
 
+func (c *Client) initOapiClient(server string, doer oapi.HttpRequestDoer) error {
 	fillHeader := func(ctx context.Context, req *http.Request) error {
-		if c.token != nil {
-			auth := c.token.Authorization()
+		if t := c.currentToken(); t != nil {
+			auth := t.Authorization()
 			if auth != "" {
-				req.Header.Set("authorization", c.token.Authorization())
+				req.Header.Set("authorization", auth)
 			}
 		}
 		req.Header.Set("appkey", c.appKey)
 		req.Header.Set("appsecret", c.appSecret)
+		c.setCustHeaders(req)
 
 		return nil
 	}
@@ -104,56 +204,147 @@ func NewClient(config *ClientConfig) (*Client, error) {
 			return nil
 		case strings.Contains(req.URL.Path, "/oauth2/tokenP"):
 			return nil
+		case strings.Contains(req.URL.Path, "/oauth2/Approval"):
+			return nil
 		}
 
 		return c.refreshToken(ctx)
 	}
+
+	var err error
 	c.oc, err = oapi.NewClient(
-		prodAddr,
+		server,
+		oapi.WithHTTPClient(doer),
 		oapi.WithRequestEditorFn(refreshToken),
 		oapi.WithRequestEditorFn(fixCodeLen),
 		oapi.WithRequestEditorFn(fillHeader),
+		oapi.WithRequestEditorFn(c.signBody),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create oapi client: %w", err)
+		return fmt.Errorf("failed to create oapi client: %w", err)
 	}
 
-	return c, nil
+	return nil
+}
+
+// tokenCall is an in-flight token acquisition shared by the concurrent requests.
+type tokenCall struct {
+	done chan struct{}
+	err  error
 }
 
+func (c *Client) currentToken() *Token {
+	c = c.root()
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.token
+}
+
+func (c *Client) setToken(t *Token) {
+	c = c.root()
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.token = t
+}
+
+// refreshToken makes sure the client has a valid token.
+// Only one goroutine acquires the token at a time and the others wait for it.
+// A canceled caller returns early but the acquisition goes on for the others.
 func (c *Client) refreshToken(ctx context.Context) error {
+	c = c.root()
+	c.tokenMu.Lock()
 	if c.token != nil && !c.token.IsExpired() {
+		c.tokenMu.Unlock()
 		return nil
 	}
+	call := c.tokenCall
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		c.tokenCall = call
+
+		// 먼저 부른 쪽이 취소해도 기다리는 다른 요청들은 토큰을 받도록
+		// 호출자의 취소와 무관하게 받아온다. 요청 시간은 ClientConfig.Timeout 으로 제한된다
+		go func() {
+			call.err = c.acquireToken(context.WithoutCancel(ctx))
 
-	tokenPath := apiEnvs["TOKEN_PATH"]
-	if tokenPath == "" {
-		tokenPath = defaultAccessTokenPath
+			c.tokenMu.Lock()
+			c.tokenCall = nil
+			c.tokenMu.Unlock()
+			close(call.done)
+		}()
 	}
+	c.tokenMu.Unlock()
 
-	var err error
-	if c.token == nil || c.token.IsExpired() {
-		if fileExists(tokenPath) {
-			c.token, err = loadAccessToken(tokenPath)
-			if err == nil {
-				return nil
-			}
-		}
-		c.token, err = c.getToken(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get token: %w", err)
-		}
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Client) acquireToken(ctx context.Context) error {
+	// 다른 프로세스가 저장소에 넣어둔 토큰이 있으면 재사용한다
+	if t, err := c.tokenStore.Load(ctx); err == nil && !t.IsExpired() {
+		c.setToken(t)
+		return nil
 	}
 
-	err = c.token.Save(tokenPath)
+	t, err := c.getToken(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+	c.setToken(t)
+
+	if err := c.tokenStore.Save(ctx, t); err != nil {
 		return fmt.Errorf("failed to save token: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) getToken(ctx context.Context) (*accessToken, error) {
+// Close revokes the access token, deletes it from the token store
+// and closes the idle connections.
+// Call it at the end of short-lived jobs not to leave a valid token on disk.
+// The token is revoked on the server, so the other processes sharing the token store lose it too.
+// Set ClientConfig.KeepTokenOnClose to only close the connections.
+func (c *Client) Close(ctx context.Context) error {
+	if hc, ok := c.httpClient.(interface{ CloseIdleConnections() }); ok {
+		defer hc.CloseIdleConnections()
+	}
+
+	if c.keepToken {
+		return nil
+	}
+
+	if err := c.RevokeToken(ctx); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// Environment returns the environment the client is connected to.
+func (c *Client) Environment() Environment {
+	return c.env
+}
+
+// trID returns the TR ID for the client's environment.
+func (c *Client) trID(id string) *string {
+	return ptr(c.env.trID(id))
+}
+
+func (c *Client) tokenPath() string {
+	if tokenPath := apiEnvs["TOKEN_PATH"]; tokenPath != "" {
+		return tokenPath
+	}
+	// 모의투자 토큰은 실전투자 토큰과 호환되지 않으므로 따로 저장한다
+	if c.env == EnvironmentVTS {
+		return defaultVtsAccessTokenPath
+	}
+	return defaultAccessTokenPath
+}
+
+func (c *Client) getToken(ctx context.Context) (*Token, error) {
 	resp, err := c.oc.PostOauth2TokenP(
 		ctx,
 		&oapi.PostOauth2TokenPParams{},
@@ -169,14 +360,35 @@ func (c *Client) getToken(ctx context.Context) (*accessToken, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, errorFromResponse(resp)
+	}
+
+	respData := &oauth2TokenPResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newToken(respData)
+}
+
+type oauth2TokenPResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"` // 유효기간(초)
+}
+
+func newToken(data *oauth2TokenPResponse) (*Token, error) {
+	if data.AccessToken == "" || data.TokenType == "" {
+		return nil, fmt.Errorf("response has no access token")
+	}
+	if data.ExpiresIn <= 0 {
+		return nil, fmt.Errorf("invalid expires_in: %d", data.ExpiresIn)
 	}
-	data := mustUnmarshalJsonBody(resp.Body)
 
-	return &accessToken{
-		TokenType:   data["token_type"].(string),
-		AccessToken: data["access_token"].(string),
-		ExpiresIn:   time.Now().Add(time.Duration((data["expires_in"].(float64))) * time.Second),
+	return &Token{
+		TokenType:   data.TokenType,
+		AccessToken: data.AccessToken,
+		ExpiresIn:   time.Now().Add(time.Duration(data.ExpiresIn) * time.Second),
 	}, nil
 }
 
@@ -240,6 +452,40 @@ func fixCodeLen(ctx context.Context, req *http.Request) error {
 	return nil
 }
 
+// withQuery sets the query param of the request.
+// Some params like PDNO are typed as int in oapi, which drops the leading zeros
+// KIS expects (e.g. "005380"), so those are set with this editor instead.
+func withQuery(key, val string) oapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set(key, val)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// withTrCont marks the request as a continuation of the previous page.
+// KIS expects tr_cont "N" with CTX_AREA_FK100/NK100 to get the next page.
+func withTrCont(next bool) oapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if next {
+			req.Header.Set("tr_cont", "N")
+		}
+		return nil
+	}
+}
+
+// hasNextPage checks the tr_cont response header for the remaining pages.
+// F or M means there are more pages, D or E means it's the last one.
+func hasNextPage(resp *http.Response) bool {
+	switch resp.Header.Get("tr_cont") {
+	case "F", "M":
+		return true
+	default:
+		return false
+	}
+}
+
 func getFiscalPeriodCode(isAnnual bool) *string {
 	// 연말 결산 여부 (0: 연말, 1: 분기)
 	if isAnnual {
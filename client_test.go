@@ -0,0 +1,273 @@
+package kinvest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient creates a client which sends the requests to srv.
+func newTestClient(t *testing.T, srv *httptest.Server) *Client {
+	t.Helper()
+
+	c := &Client{
+		httpClient: srv.Client(),
+		account:    Account{CANO: "12345678", ProductCode: ProductStock},
+		env:        EnvironmentProd,
+		custType:   CustTypePersonal,
+		appKey:     "appkey",
+		appSecret:  "appsecret",
+		tokenStore: NewMemoryTokenStore(),
+	}
+	if err := c.initOapiClient(srv.URL, c.httpClient); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClientConcurrentTokenRefresh(t *testing.T) {
+	var tokenCnt, priceCnt atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			tokenCnt.Add(1)
+			time.Sleep(50 * time.Millisecond) // 다른 요청들이 토큰을 기다리도록
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-price":
+			priceCnt.Add(1)
+			if r.Header.Get("authorization") != "Bearer test-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"rt_cd":"1","msg_cd":"EGW00121","msg1":"유효하지 않은 token 입니다."}`))
+				return
+			}
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output":{"stck_prpr":"71900","prdy_vrss_sign":"2"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	const n = 20
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p, err := c.GetDomesticInquirePrice(ctx, "005930")
+			if assert.NoError(t, err) {
+				assert.Equal(t, int64(71900), p.StckPrpr)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), tokenCnt.Load())
+	assert.Equal(t, int32(n), priceCnt.Load())
+}
+
+func TestClientTokenRefreshCanceled(t *testing.T) {
+	requested := make(chan struct{})
+	release := make(chan struct{})
+	var tokenCnt atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth2/tokenP" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if tokenCnt.Add(1) == 1 {
+			close(requested)
+		}
+		<-release
+		w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := newTestClient(t, srv)
+
+	// 먼저 토큰을 요청한 쪽이 취소해도 기다리던 쪽은 토큰을 받는다
+	ctx1, cancel := context.WithCancel(context.Background())
+	err1 := make(chan error)
+	go func() { err1 <- c.refreshToken(ctx1) }()
+	<-requested
+
+	err2 := make(chan error)
+	go func() { err2 <- c.refreshToken(context.Background()) }()
+
+	cancel()
+	assert.ErrorIs(t, <-err1, context.Canceled)
+	release <- struct{}{}
+	assert.NoError(t, <-err2)
+	if tok := c.currentToken(); assert.NotNil(t, tok) {
+		assert.Equal(t, "test-token", tok.AccessToken)
+	}
+	assert.Equal(t, int32(1), tokenCnt.Load())
+}
+
+func TestTokenSaveAtomic(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token.yaml")
+
+	token := &Token{TokenType: "Bearer", AccessToken: "test-token", ExpiresIn: time.Now().Add(time.Hour)}
+	assert.NoError(t, token.Save(tokenPath))
+	assert.NoError(t, token.Save(tokenPath))
+
+	loaded, err := loadToken(tokenPath)
+	assert.NoError(t, err)
+	assert.Equal(t, token.Authorization(), loaded.Authorization())
+
+	// 임시파일이 남지 않아야 한다
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestNewClientWithBaseURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-price":
+			if r.URL.Query().Get("fid_input_iscd") == "000660" {
+				time.Sleep(200 * time.Millisecond) // 요청 시간 초과
+			}
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output":{"stck_prpr":"71900"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(&ClientConfig{
+		AppKey:     "appkey",
+		AppSecret:  "appsecret",
+		Account:    "12345678-01",
+		TokenStore: NewMemoryTokenStore(),
+		HTTPClient: srv.Client(),
+		BaseURL:    srv.URL,
+		Timeout:    50 * time.Millisecond,
+		Retry:      &RetryPolicy{MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	price, err := c.GetDomesticInquirePrice(ctx, "005930")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(71900), price.StckPrpr)
+	}
+
+	_, err = c.GetDomesticInquirePrice(ctx, "000660")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientGatewayErrorPage(t *testing.T) {
+	var tokenOK atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/tokenP" && tokenOK.Load() {
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+			return
+		}
+		w.Write([]byte(`<html><body>502 Bad Gateway</body></html>`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	_, err := c.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.Error(t, err)
+
+	tokenOK.Store(true)
+	_, err = c.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.ErrorContains(t, err, "unmarshal response failed")
+
+	_, err = c.GetDomesticHoldings(ctx, nil)
+	assert.ErrorContains(t, err, "unmarshal response failed")
+}
+
+func TestClientCustHeaders(t *testing.T) {
+	var mu sync.Mutex
+	headers := make(map[string]http.Header)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers[r.URL.Path] = r.Header.Clone()
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/hashkey":
+			w.Write([]byte(`{"BODY":{},"HASH":"test-hash"}`))
+		case "/uapi/domestic-stock/v1/trading/order-cash":
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"APBK0013","msg1":"주문 전송 완료 되었습니다.","output":{"KRX_FWDG_ORD_ORGNO":"91252","ODNO":"0000117057","ORD_TMD":"121052"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	config := &ClientConfig{
+		AppKey:     "appkey",
+		AppSecret:  "appsecret",
+		Account:    "12345678-01",
+		TokenStore: NewMemoryTokenStore(),
+		HTTPClient: srv.Client(),
+		BaseURL:    srv.URL,
+		CustType:   CustTypeBusiness,
+	}
+	_, err := NewClient(config)
+	assert.Error(t, err, "corporate config is required")
+
+	config.Corporate = &CorporateConfig{
+		PersonalSecKey: "seckey",
+		PhoneNumber:    "01012345678",
+		IPAddr:         "203.0.113.1",
+		MACAddress:     "00-00-5E-00-53-00",
+	}
+	config.Hashkey = true
+	c, err := NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	_, err = c.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.NoError(t, err)
+
+	h := headers["/uapi/domestic-stock/v1/trading/order-cash"]
+	assert.Equal(t, "B", h.Get("custtype"))
+	assert.Equal(t, "seckey", h.Get("personalseckey"))
+	assert.Equal(t, "01012345678", h.Get("phone_number"))
+	assert.Equal(t, "203.0.113.1", h.Get("ip_addr"))
+	assert.Equal(t, "00-00-5E-00-53-00", h.Get("mac_address"))
+	assert.Len(t, h.Get("gt_uid"), 32)
+	assert.NotEqual(t, h.Get("gt_uid"), headers["/uapi/hashkey"].Get("gt_uid"))
+	assert.Equal(t, "test-hash", h.Get("hashkey"))
+
+	// 개인은 IP, MAC 없이 custtype 만 보낸다
+	config.CustType, config.Corporate, config.Hashkey = "", nil, false
+	c, err = NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.NoError(t, err)
+
+	h = headers["/uapi/domestic-stock/v1/trading/order-cash"]
+	assert.Equal(t, "P", h.Get("custtype"))
+	assert.Empty(t, h.Get("ip_addr"))
+	assert.Empty(t, h.Get("hashkey"))
+}
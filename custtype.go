@@ -0,0 +1,95 @@
+package kinvest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+// CustType is the customer type (고객타입) of the account.
+type CustType string
+
+const (
+	CustTypePersonal CustType = "P" // 개인
+	CustTypeBusiness CustType = "B" // 법인
+)
+
+func parseCustType(s string) (CustType, error) {
+	switch CustType(s) {
+	case "", CustTypePersonal:
+		return CustTypePersonal, nil
+	case CustTypeBusiness:
+		return CustTypeBusiness, nil
+	default:
+		return "", fmt.Errorf("invalid cust type: %s, set one of the following: %s, %s", s, CustTypePersonal, CustTypeBusiness)
+	}
+}
+
+// CorporateConfig holds the headers required for the corporate (법인) customers.
+// If the token of the corporate account is issued by the authorization code grant,
+// put it into ClientConfig.TokenStore.
+type CorporateConfig struct {
+	PersonalSecKey string // 고객식별키
+	PhoneNumber    string // 휴대폰번호, e.g. 01012345678
+	SeqNo          string // 일련번호, 설정하지 않으면 보내지 않음
+	IPAddr         string // 접속 단말 공인 IP, 설정하지 않으면 로컬 IP
+	MACAddress     string // 접속 단말 MAC 주소, 설정하지 않으면 로컬 MAC, 없으면 보내지 않음
+}
+
+// setCorporate validates the corporate config and fills the IP and MAC of the client.
+// The local network interfaces are looked up only if they are not in the config.
+func (c *Client) setCorporate(corp *CorporateConfig) error {
+	if corp == nil || corp.PersonalSecKey == "" || corp.PhoneNumber == "" {
+		return fmt.Errorf("personal sec key and phone number must be set for %s", CustTypeBusiness)
+	}
+	c.corp = corp
+	c.ip, c.mac = corp.IPAddr, corp.MACAddress
+	if c.ip != "" && c.mac != "" {
+		return nil
+	}
+
+	ip, mac, err := getLocalIPAndMAC()
+	if c.ip == "" {
+		if err != nil {
+			return fmt.Errorf("failed to get local IP: %w", err)
+		}
+		c.ip = ip
+	}
+	if c.mac == "" {
+		// MAC 주소는 선택 항목이므로 MAC 이 없는 컨테이너에서도 동작하도록 비워 둔다
+		c.mac = mac
+	}
+	return nil
+}
+
+// setCustHeaders sets the custtype header and the corporate customer headers.
+func (c *Client) setCustHeaders(req *http.Request) {
+	req.Header.Set("custtype", string(c.custType))
+	if c.custType != CustTypeBusiness {
+		return
+	}
+
+	req.Header.Set("personalseckey", c.corp.PersonalSecKey)
+	req.Header.Set("phone_number", c.corp.PhoneNumber)
+	req.Header.Set("ip_addr", c.ip)
+	req.Header.Set("gt_uid", newGtUID())
+	if c.mac != "" {
+		req.Header.Set("mac_address", c.mac)
+	}
+	if c.corp.SeqNo != "" {
+		req.Header.Set("seq_no", c.corp.SeqNo)
+	}
+}
+
+// CustType returns the customer type of the client.
+func (c *Client) CustType() CustType {
+	return c.custType
+}
+
+// newGtUID returns a new Global UID (거래고유번호) which is unique for each request.
+func newGtUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
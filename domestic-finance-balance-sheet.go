@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceBalanceSheet(ctx context.Context, code string
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430100"),
+			TrId:               c.trID("FHKST66430100"),
 		},
 	)
 	if err != nil {
@@ -33,17 +35,37 @@ func (c *Client) GetDomesticFinanceBalanceSheet(ctx context.Context, code string
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return validateDomesticFinanceBalanceSheet(respData)
+	return validateDomesticFinanceBalanceSheet(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceBalanceSheetResponse struct {
-	Output []*DomesticFinanceBalanceSheet `json:"output"`
-	RtCd   string                         `json:"rt_cd"`
-	MsgCd  string                         `json:"msg_cd"`
-	Msg1   string                         `json:"msg1"`
+	Output []*DomesticFinanceBalanceSheetRaw `json:"output"`
+	RtCd   string                            `json:"rt_cd"`
+	MsgCd  string                            `json:"msg_cd"`
+	Msg1   string                            `json:"msg1"`
 }
 
+// DomesticFinanceBalanceSheet represents 대차대조표 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceBalanceSheet struct {
+	StacYymm  time.Time `yaml:"결산년월,omitempty"`  // 결산 년월
+	Cras      float64   `yaml:"유동자산,omitempty"`  // 유동자산
+	Fxas      float64   `yaml:"고정자산,omitempty"`  // 고정자산
+	TotalAset float64   `yaml:"자산총계,omitempty"`  // 자산총계
+	FlowLblt  float64   `yaml:"유동부채,omitempty"`  // 유동부채
+	FixLblt   float64   `yaml:"고정부채,omitempty"`  // 고정부채
+	TotalLblt float64   `yaml:"부채총계,omitempty"`  // 부채총계
+	Cpfn      float64   `yaml:"자본금,omitempty"`   // 자본금
+	CfpSurp   float64   `yaml:"자본잉여금,omitempty"` // 자본 잉여금
+	PrfiSurp  float64   `yaml:"이익잉여금,omitempty"` // 이익 잉여금
+	TotalCptl float64   `yaml:"자본총계,omitempty"`  // 자본총계
+
+	Raw *DomesticFinanceBalanceSheetRaw `yaml:"-"`
+}
+
+// DomesticFinanceBalanceSheetRaw is 대차대조표 as the API returns, in strings.
+type DomesticFinanceBalanceSheetRaw struct {
 	StacYymm  string `json:"stac_yymm,omitempty" yaml:"결산년월,omitempty"`  // 결산 년월
 	Cras      string `json:"cras,omitempty" yaml:"유동자산,omitempty"`       // 유동자산
 	Fxas      string `json:"fxas,omitempty" yaml:"고정자산,omitempty"`       // 고정자산
@@ -57,18 +79,47 @@ type DomesticFinanceBalanceSheet struct {
 	TotalCptl string `json:"total_cptl,omitempty" yaml:"자본총계,omitempty"` // 자본총계
 }
 
-func validateDomesticFinanceBalanceSheet(data *uapiDomesticStockV1FinanceBalanceSheetResponse) ([]*DomesticFinanceBalanceSheet, error) {
+func newDomesticFinanceBalanceSheet(r *DomesticFinanceBalanceSheetRaw) *DomesticFinanceBalanceSheet {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceBalanceSheet{
+		StacYymm:  yymmToTime(r.StacYymm),
+		Cras:      toFloat(r.Cras),
+		Fxas:      toFloat(r.Fxas),
+		TotalAset: toFloat(r.TotalAset),
+		FlowLblt:  toFloat(r.FlowLblt),
+		FixLblt:   toFloat(r.FixLblt),
+		TotalLblt: toFloat(r.TotalLblt),
+		Cpfn:      toFloat(r.Cpfn),
+		CfpSurp:   toFloat(r.CfpSurp),
+		PrfiSurp:  toFloat(r.PrfiSurp),
+		TotalCptl: toFloat(r.TotalCptl),
+		Raw:       r,
+	}
+}
+
+func validateDomesticFinanceBalanceSheet(resp *http.Response, data *uapiDomesticStockV1FinanceBalanceSheetResponse) ([]*DomesticFinanceBalanceSheet, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return data.Output, nil
+	ret := make([]*DomesticFinanceBalanceSheet, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceBalanceSheet(o))
+	}
+
+	return ret, nil
 }
@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceFinancialRatio(ctx context.Context, code stri
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430300"),
+			TrId:               c.trID("FHKST66430300"),
 		},
 	)
 	if err != nil {
@@ -33,17 +35,36 @@ func (c *Client) GetDomesticFinanceFinancialRatio(ctx context.Context, code stri
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return validateDomesticFinanceFinancialRatio(respData)
+	return validateDomesticFinanceFinancialRatio(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceFinancialRatioResponse struct {
-	Output []*DomesticFinanceFinancialRatio `json:"output"`
-	RtCd   string                           `json:"rt_cd"`
-	MsgCd  string                           `json:"msg_cd"`
-	Msg1   string                           `json:"msg1"`
+	Output []*DomesticFinanceFinancialRatioRaw `json:"output"`
+	RtCd   string                              `json:"rt_cd"`
+	MsgCd  string                              `json:"msg_cd"`
+	Msg1   string                              `json:"msg1"`
 }
 
+// DomesticFinanceFinancialRatio represents 재무비율 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceFinancialRatio struct {
+	StacYymm     time.Time `yaml:"결산년월,omitempty"`    // 결산 년월
+	Grs          float64   `yaml:"매출액증가율,omitempty"`  // 매출액 증가율
+	BsopPrfiInrt float64   `yaml:"영업이익증가율,omitempty"` // 영업 이익 증가율
+	NtinInrt     float64   `yaml:"순이익증가율,omitempty"`  // 순이익 증가율
+	RoeVal       float64   `yaml:"ROE값,omitempty"`    // ROE 값
+	Eps          float64   `yaml:"EPS,omitempty"`     // EPS
+	Sps          float64   `yaml:"주당매출액,omitempty"`   // 주당매출액
+	Bps          float64   `yaml:"BPS,omitempty"`     // BPS
+	RsrvRate     float64   `yaml:"유보비율,omitempty"`    // 유보 비율
+	LbltRate     float64   `yaml:"부채비율,omitempty"`    // 부채 비율
+
+	Raw *DomesticFinanceFinancialRatioRaw `yaml:"-"`
+}
+
+// DomesticFinanceFinancialRatioRaw is 재무비율 as the API returns, in strings.
+type DomesticFinanceFinancialRatioRaw struct {
 	StacYymm     string `json:"stac_yymm,omitempty" yaml:"결산년월,omitempty"`         // 결산 년월
 	Grs          string `json:"grs,omitempty" yaml:"매출액증가율,omitempty"`             // 매출액 증가율
 	BsopPrfiInrt string `json:"bsop_prfi_inrt,omitempty" yaml:"영업이익증가율,omitempty"` // 영업 이익 증가율
@@ -56,18 +77,46 @@ type DomesticFinanceFinancialRatio struct {
 	LbltRate     string `json:"lblt_rate,omitempty" yaml:"부채비율,omitempty"`         // 부채 비율
 }
 
-func validateDomesticFinanceFinancialRatio(data *uapiDomesticStockV1FinanceFinancialRatioResponse) ([]*DomesticFinanceFinancialRatio, error) {
+func newDomesticFinanceFinancialRatio(r *DomesticFinanceFinancialRatioRaw) *DomesticFinanceFinancialRatio {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceFinancialRatio{
+		StacYymm:     yymmToTime(r.StacYymm),
+		Grs:          toFloat(r.Grs),
+		BsopPrfiInrt: toFloat(r.BsopPrfiInrt),
+		NtinInrt:     toFloat(r.NtinInrt),
+		RoeVal:       toFloat(r.RoeVal),
+		Eps:          toFloat(r.Eps),
+		Sps:          toFloat(r.Sps),
+		Bps:          toFloat(r.Bps),
+		RsrvRate:     toFloat(r.RsrvRate),
+		LbltRate:     toFloat(r.LbltRate),
+		Raw:          r,
+	}
+}
+
+func validateDomesticFinanceFinancialRatio(resp *http.Response, data *uapiDomesticStockV1FinanceFinancialRatioResponse) ([]*DomesticFinanceFinancialRatio, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return data.Output, nil
+	ret := make([]*DomesticFinanceFinancialRatio, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceFinancialRatio(o))
+	}
+
+	return ret, nil
 }
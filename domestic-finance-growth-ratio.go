@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceGrowthRatio(ctx context.Context, code string,
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430800"),
+			TrId:               c.trID("FHKST66430800"),
 		},
 	)
 
@@ -34,17 +36,31 @@ func (c *Client) GetDomesticFinanceGrowthRatio(ctx context.Context, code string,
 		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
 
-	return validateDomesticFinanceGrowthRatio(respData)
+	return validateDomesticFinanceGrowthRatio(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceGrowthRatioResponse struct {
-	Output []*DomesticFinanceGrowthRatio `json:"output"`
-	RtCd   string                        `json:"rt_cd"`
-	MsgCd  string                        `json:"msg_cd"`
-	Msg1   string                        `json:"msg1"`
+	Output []*DomesticFinanceGrowthRatioRaw `json:"output"`
+	RtCd   string                           `json:"rt_cd"`
+	MsgCd  string                           `json:"msg_cd"`
+	Msg1   string                           `json:"msg1"`
 }
 
+// DomesticFinanceGrowthRatio represents 성장성비율 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceGrowthRatio struct {
+	StacYymm     time.Time `yaml:"결산년월,omitempty"`    // 결산 년월
+	Grs          float64   `yaml:"매출액증가율,omitempty"`  // 매출액 증가율
+	BsopPrfiInrt float64   `yaml:"영업이익증가율,omitempty"` // 영업 이익 증가율
+	EqutInrt     float64   `yaml:"자기자본증가율,omitempty"` // 자기자본 증가율
+	TotlAsetInrt float64   `yaml:"총자산증가율,omitempty"`  // 총자산 증가율
+
+	Raw *DomesticFinanceGrowthRatioRaw `yaml:"-"`
+}
+
+// DomesticFinanceGrowthRatioRaw is 성장성비율 as the API returns, in strings.
+type DomesticFinanceGrowthRatioRaw struct {
 	StacYymm     string `json:"stac_yymm,omitempty" yaml:"결산년월,omitempty"`         // 결산 년월
 	Grs          string `json:"grs,omitempty" yaml:"매출액증가율,omitempty"`             // 매출액 증가율
 	BsopPrfiInrt string `json:"bsop_prfi_inrt,omitempty" yaml:"영업이익증가율,omitempty"` // 영업 이익 증가율
@@ -52,18 +68,41 @@ type DomesticFinanceGrowthRatio struct {
 	TotlAsetInrt string `json:"totl_aset_inrt,omitempty" yaml:"총자산증가율,omitempty"`  // 총자산 증가율
 }
 
-func validateDomesticFinanceGrowthRatio(data *uapiDomesticStockV1FinanceGrowthRatioResponse) ([]*DomesticFinanceGrowthRatio, error) {
+func newDomesticFinanceGrowthRatio(r *DomesticFinanceGrowthRatioRaw) *DomesticFinanceGrowthRatio {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceGrowthRatio{
+		StacYymm:     yymmToTime(r.StacYymm),
+		Grs:          toFloat(r.Grs),
+		BsopPrfiInrt: toFloat(r.BsopPrfiInrt),
+		EqutInrt:     toFloat(r.EqutInrt),
+		TotlAsetInrt: toFloat(r.TotlAsetInrt),
+		Raw:          r,
+	}
+}
+
+func validateDomesticFinanceGrowthRatio(resp *http.Response, data *uapiDomesticStockV1FinanceGrowthRatioResponse) ([]*DomesticFinanceGrowthRatio, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return data.Output, nil
+	ret := make([]*DomesticFinanceGrowthRatio, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceGrowthRatio(o))
+	}
+
+	return ret, nil
 }
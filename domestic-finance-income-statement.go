@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceIncomeStatement(ctx context.Context, code str
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430200"),
+			TrId:               c.trID("FHKST66430200"),
 		},
 	)
 	if err != nil {
@@ -33,17 +35,39 @@ func (c *Client) GetDomesticFinanceIncomeStatement(ctx context.Context, code str
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return validateDomesticFinanceIncomeStatement(respData)
+	return validateDomesticFinanceIncomeStatement(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceIncomeStatementResponse struct {
-	Output []*DomesticFinanceIncomeStatement `json:"output"`
-	RtCd   string                            `json:"rt_cd"`
-	MsgCd  string                            `json:"msg_cd"`
-	Msg1   string                            `json:"msg1"`
+	Output []*DomesticFinanceIncomeStatementRaw `json:"output"`
+	RtCd   string                               `json:"rt_cd"`
+	MsgCd  string                               `json:"msg_cd"`
+	Msg1   string                               `json:"msg1"`
 }
 
+// DomesticFinanceIncomeStatement represents 손익계산서 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceIncomeStatement struct {
+	StacYymm     time.Time `yaml:"결산년월,omitempty"`   // 결산 년월
+	SaleAccount  float64   `yaml:"매출액,omitempty"`    // 매출액
+	SaleCost     float64   `yaml:"매출원가,omitempty"`   // 매출 원가
+	SaleTotlPrfi float64   `yaml:"매출총이익,omitempty"`  // 매출 총 이익
+	DeprCost     float64   `yaml:"감가상각비,omitempty"`  // 감가상각비
+	SellMang     float64   `yaml:"판매및관리비,omitempty"` // 판매 및 관리비
+	BsopPrti     float64   `yaml:"영업이익,omitempty"`   // 영업 이익
+	BsopNonErnn  float64   `yaml:"영업외수익,omitempty"`  // 영업 외 수익
+	BsopNonExpn  float64   `yaml:"영업외비용,omitempty"`  // 영업 외 비용
+	OpPrfi       float64   `yaml:"경상이익,omitempty"`   // 경상 이익
+	SpecPrfi     float64   `yaml:"특별이익,omitempty"`   // 특별 이익
+	SpecLoss     float64   `yaml:"특별손실,omitempty"`   // 특별 손실
+	ThtrNtin     float64   `yaml:"당기순이익,omitempty"`  // 당기순이익
+
+	Raw *DomesticFinanceIncomeStatementRaw `yaml:"-"`
+}
+
+// DomesticFinanceIncomeStatementRaw is 손익계산서 as the API returns, in strings.
+type DomesticFinanceIncomeStatementRaw struct {
 	StacYymm     string `json:"stac_yymm" yaml:"결산년월,omitempty"`       // 결산 년월
 	SaleAccount  string `json:"sale_account" yaml:"매출액,omitempty"`     // 매출액
 	SaleCost     string `json:"sale_cost" yaml:"매출원가,omitempty"`       // 매출 원가
@@ -59,14 +83,45 @@ type DomesticFinanceIncomeStatement struct {
 	ThtrNtin     string `json:"thtr_ntin" yaml:"당기순이익,omitempty"`      // 당기순이익
 }
 
-func validateDomesticFinanceIncomeStatement(data *uapiDomesticStockV1FinanceIncomeStatementResponse) ([]*DomesticFinanceIncomeStatement, error) {
+func newDomesticFinanceIncomeStatement(r *DomesticFinanceIncomeStatementRaw) *DomesticFinanceIncomeStatement {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceIncomeStatement{
+		StacYymm:     yymmToTime(r.StacYymm),
+		SaleAccount:  toFloat(r.SaleAccount),
+		SaleCost:     toFloat(r.SaleCost),
+		SaleTotlPrfi: toFloat(r.SaleTotlPrfi),
+		DeprCost:     toFloat(r.DeprCost),
+		SellMang:     toFloat(r.SellMang),
+		BsopPrti:     toFloat(r.BsopPrti),
+		BsopNonErnn:  toFloat(r.BsopNonErnn),
+		BsopNonExpn:  toFloat(r.BsopNonExpn),
+		OpPrfi:       toFloat(r.OpPrfi),
+		SpecPrfi:     toFloat(r.SpecPrfi),
+		SpecLoss:     toFloat(r.SpecLoss),
+		ThtrNtin:     toFloat(r.ThtrNtin),
+		Raw:          r,
+	}
+}
+
+func validateDomesticFinanceIncomeStatement(resp *http.Response, data *uapiDomesticStockV1FinanceIncomeStatementResponse) ([]*DomesticFinanceIncomeStatement, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	ret := make([]*DomesticFinanceIncomeStatement, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceIncomeStatement(o))
 	}
 
-	return data.Output, nil
+	return ret, nil
 }
@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceProfitRatio(ctx context.Context, code string,
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430400"),
+			TrId:               c.trID("FHKST66430400"),
 		},
 	)
 	if err != nil {
@@ -33,17 +35,31 @@ func (c *Client) GetDomesticFinanceProfitRatio(ctx context.Context, code string,
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return validateDomesticFinanceProfitRatio(respData)
+	return validateDomesticFinanceProfitRatio(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceProfitRatioResponse struct {
-	Output []*DomesticFinanceProfitRatio `json:"output"`
-	RtCd   string                        `json:"rt_cd"`
-	MsgCd  string                        `json:"msg_cd"`
-	Msg1   string                        `json:"msg1"`
+	Output []*DomesticFinanceProfitRatioRaw `json:"output"`
+	RtCd   string                           `json:"rt_cd"`
+	MsgCd  string                           `json:"msg_cd"`
+	Msg1   string                           `json:"msg1"`
 }
 
+// DomesticFinanceProfitRatio represents 수익성비율 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceProfitRatio struct {
+	StacYymm         time.Time `yaml:"결산년월,omitempty"`     // 결산 년월
+	CptlNtinRate     float64   `yaml:"총자본순이익율,omitempty"`  // 총자본 순이익율
+	SelfCptlNtinInrt float64   `yaml:"자기자본순이익율,omitempty"` // 자기자본 순이익율
+	SaleNtinRate     float64   `yaml:"매출액순이익율,omitempty"`  // 매출액 순이익율
+	SaleTotlRate     float64   `yaml:"매출액총이익율,omitempty"`  // 매출액 총이익율
+
+	Raw *DomesticFinanceProfitRatioRaw `yaml:"-"`
+}
+
+// DomesticFinanceProfitRatioRaw is 수익성비율 as the API returns, in strings.
+type DomesticFinanceProfitRatioRaw struct {
 	StacYymm         string `json:"stac_yymm,omitempty" yaml:"결산년월,omitempty"`               // 결산 년월
 	CptlNtinRate     string `json:"cptl_ntin_rate,omitempty" yaml:"총자본순이익율,omitempty"`       // 총자본 순이익율
 	SelfCptlNtinInrt string `json:"self_cptl_ntin_inrt,omitempty" yaml:"자기자본순이익율,omitempty"` // 자기자본 순이익율
@@ -51,18 +67,41 @@ type DomesticFinanceProfitRatio struct {
 	SaleTotlRate     string `json:"sale_totl_rate,omitempty" yaml:"매출액총이익율,omitempty"`       // 매출액 총이익율
 }
 
-func validateDomesticFinanceProfitRatio(data *uapiDomesticStockV1FinanceProfitRatioResponse) ([]*DomesticFinanceProfitRatio, error) {
+func newDomesticFinanceProfitRatio(r *DomesticFinanceProfitRatioRaw) *DomesticFinanceProfitRatio {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceProfitRatio{
+		StacYymm:         yymmToTime(r.StacYymm),
+		CptlNtinRate:     toFloat(r.CptlNtinRate),
+		SelfCptlNtinInrt: toFloat(r.SelfCptlNtinInrt),
+		SaleNtinRate:     toFloat(r.SaleNtinRate),
+		SaleTotlRate:     toFloat(r.SaleTotlRate),
+		Raw:              r,
+	}
+}
+
+func validateDomesticFinanceProfitRatio(resp *http.Response, data *uapiDomesticStockV1FinanceProfitRatioResponse) ([]*DomesticFinanceProfitRatio, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return data.Output, nil
+	ret := make([]*DomesticFinanceProfitRatio, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceProfitRatio(o))
+	}
+
+	return ret, nil
 }
@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -20,7 +22,7 @@ func (c *Client) GetDomesticFinanceStabilityRatio(ctx context.Context, code stri
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
 			FidDivClsCode:      getFiscalPeriodCode(anualFiscal),
-			TrId:               ptr("FHKST66430500"),
+			TrId:               c.trID("FHKST66430500"),
 		},
 	)
 	if err != nil {
@@ -33,17 +35,31 @@ func (c *Client) GetDomesticFinanceStabilityRatio(ctx context.Context, code stri
 		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
 
-	return validateDomesticFinanceStabilityRatio(respData)
+	return validateDomesticFinanceStabilityRatio(resp, respData)
 }
 
 type uapiDomesticStockV1FinanceStabilityRatioResponse struct {
-	Output []*DomesticFinanceStabilityRatio `json:"output"`
-	RtCd   string                           `json:"rt_cd"`
-	MsgCd  string                           `json:"msg_cd"`
-	Msg1   string                           `json:"msg1"`
+	Output []*DomesticFinanceStabilityRatioRaw `json:"output"`
+	RtCd   string                              `json:"rt_cd"`
+	MsgCd  string                              `json:"msg_cd"`
+	Msg1   string                              `json:"msg1"`
 }
 
+// DomesticFinanceStabilityRatio represents 안정성비율 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticFinanceStabilityRatio struct {
+	StacYymm time.Time `yaml:"결산년월,omitempty"`   // 결산 년월
+	LbltRate float64   `yaml:"부채비율,omitempty"`   // 부채 비율
+	BramDepn float64   `yaml:"차입금의존도,omitempty"` // 차입금 의존도
+	CrntRate float64   `yaml:"유동비율,omitempty"`   // 유동 비율
+	QuckRate float64   `yaml:"당좌비율,omitempty"`   // 당좌 비율
+
+	Raw *DomesticFinanceStabilityRatioRaw `yaml:"-"`
+}
+
+// DomesticFinanceStabilityRatioRaw is 안정성비율 as the API returns, in strings.
+type DomesticFinanceStabilityRatioRaw struct {
 	StacYymm string `json:"stac_yymm,omitempty" yaml:"결산년월,omitempty"`   // 결산 년월
 	LbltRate string `json:"lblt_rate,omitempty" yaml:"부채비율,omitempty"`   // 부채 비율
 	BramDepn string `json:"bram_depn,omitempty" yaml:"차입금의존도,omitempty"` // 차입금 의존도
@@ -51,18 +67,41 @@ type DomesticFinanceStabilityRatio struct {
 	QuckRate string `json:"quck_rate,omitempty" yaml:"당좌비율,omitempty"`   // 당좌 비율
 }
 
-func validateDomesticFinanceStabilityRatio(data *uapiDomesticStockV1FinanceStabilityRatioResponse) ([]*DomesticFinanceStabilityRatio, error) {
+func newDomesticFinanceStabilityRatio(r *DomesticFinanceStabilityRatioRaw) *DomesticFinanceStabilityRatio {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticFinanceStabilityRatio{
+		StacYymm: yymmToTime(r.StacYymm),
+		LbltRate: toFloat(r.LbltRate),
+		BramDepn: toFloat(r.BramDepn),
+		CrntRate: toFloat(r.CrntRate),
+		QuckRate: toFloat(r.QuckRate),
+		Raw:      r,
+	}
+}
+
+func validateDomesticFinanceStabilityRatio(resp *http.Response, data *uapiDomesticStockV1FinanceStabilityRatioResponse) ([]*DomesticFinanceStabilityRatio, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return data.Output, nil
+	ret := make([]*DomesticFinanceStabilityRatio, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticFinanceStabilityRatio(o))
+	}
+
+	return ret, nil
 }
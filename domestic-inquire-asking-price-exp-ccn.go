@@ -0,0 +1,161 @@
+// 국내주식 > 기본시세 > 주식현재가 호가/예상체결
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// GetDomesticOrderBook retrieves the 10 levels order book and the expected execution of the stock.
+func (c *Client) GetDomesticOrderBook(ctx context.Context, code string) (*OrderBook, error) {
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+
+	resp, err := c.oc.GetUapiDomesticStockV1QuotationsInquireAskingPriceExpCcn(
+		ctx,
+		&oapi.GetUapiDomesticStockV1QuotationsInquireAskingPriceExpCcnParams{
+			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
+			TrId:               c.trID("FHKST01010200"),
+		},
+		withQuery("fid_input_iscd", code),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respData := &uapiDomesticStockV1QuotationsInquireAskingPriceExpCcnResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return validateDomesticOrderBook(resp, respData)
+}
+
+// OrderBookLevel is a price level of the order book.
+type OrderBookLevel struct {
+	Price     int64 `yaml:"호가"`
+	Qty       int64 `yaml:"잔량"`
+	QtyChange int64 `yaml:"잔량증감"` // 직전 대비 잔량 증감
+}
+
+// OrderBook represents 주식현재가 호가/예상체결.
+// Asks and Bids are ordered from the best price. The empty levels have zero price.
+type OrderBook struct {
+	Code          string             `yaml:"종목코드"`
+	Time          time.Time          `yaml:"호가접수시간"`
+	Asks          [10]OrderBookLevel `yaml:"매도호가"`
+	Bids          [10]OrderBookLevel `yaml:"매수호가"`
+	TotalAskQty   int64              `yaml:"총매도호가잔량"`
+	TotalBidQty   int64              `yaml:"총매수호가잔량"`
+	Price         int64              `yaml:"현재가"`
+	ExpectedPrice int64              `yaml:"예상체결가,omitempty"` // 동시호가 중에만 유효
+	ExpectedQty   int64              `yaml:"예상거래량,omitempty"` // 동시호가 중에만 유효
+	ExpectedSign  ChangeSign         `yaml:"예상체결대비부호,omitempty"`
+	ExpectedDiff  int64              `yaml:"예상체결대비,omitempty"`
+	ExpectedRate  float64            `yaml:"예상체결전일대비율,omitempty"`
+}
+
+// Spread returns the best ask minus the best bid.
+// It returns 0 if either side is empty.
+func (ob *OrderBook) Spread() int64 {
+	ask, bid := ob.Asks[0].Price, ob.Bids[0].Price
+	if ask == 0 || bid == 0 {
+		return 0
+	}
+	return ask - bid
+}
+
+// Mid returns the middle of the best ask and the best bid.
+// It returns 0 if either side is empty.
+func (ob *OrderBook) Mid() float64 {
+	ask, bid := ob.Asks[0].Price, ob.Bids[0].Price
+	if ask == 0 || bid == 0 {
+		return 0
+	}
+	return float64(ask+bid) / 2
+}
+
+// Imbalance returns (bid - ask) / (bid + ask) of the total remaining quantities, in [-1, 1].
+// Positive means more buyers waiting.
+func (ob *OrderBook) Imbalance() float64 {
+	total := ob.TotalBidQty + ob.TotalAskQty
+	if total == 0 {
+		return 0
+	}
+	return float64(ob.TotalBidQty-ob.TotalAskQty) / float64(total)
+}
+
+type uapiDomesticStockV1QuotationsInquireAskingPriceExpCcnResponse struct {
+	// 호가 필드가 askp1 ~ askp10 처럼 번호로 구분되므로 map 으로 받는다
+	Output1 map[string]string     `json:"output1"`
+	Output2 *askingPriceExpOutput `json:"output2"`
+	RtCd    string                `json:"rt_cd"`
+	MsgCd   string                `json:"msg_cd"`
+	Msg1    string                `json:"msg1"`
+}
+
+type askingPriceExpOutput struct {
+	AntcMkopClsCode  string `json:"antc_mkop_cls_code"`  // 예상 장운영 구분 코드
+	StckPrpr         string `json:"stck_prpr"`           // 주식 현재가
+	AntcCnpr         string `json:"antc_cnpr"`           // 예상 체결가
+	AntcCntgVrssSign string `json:"antc_cntg_vrss_sign"` // 예상 체결 대비 부호
+	AntcCntgVrss     string `json:"antc_cntg_vrss"`      // 예상 체결 대비
+	AntcCntgPrdyCtrt string `json:"antc_cntg_prdy_ctrt"` // 예상 체결 전일 대비율
+	AntcVol          string `json:"antc_vol"`            // 예상 거래량
+	StckShrnIscd     string `json:"stck_shrn_iscd"`      // 주식 단축 종목코드
+}
+
+func validateDomesticOrderBook(resp *http.Response, data *uapiDomesticStockV1QuotationsInquireAskingPriceExpCcnResponse) (*OrderBook, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+	if data.Output1 == nil {
+		return nil, fmt.Errorf("no order book in response")
+	}
+
+	o1 := data.Output1
+	ob := &OrderBook{
+		TotalAskQty: toInt64(o1["total_askp_rsqn"]),
+		TotalBidQty: toInt64(o1["total_bidp_rsqn"]),
+	}
+	if hour := o1["aspr_acpt_hour"]; hour != "" {
+		t, err := conv.HHMMSSToTime(hour)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order book time: %s", hour)
+		}
+		ob.Time = t
+	}
+	for i := range 10 {
+		n := i + 1
+		ob.Asks[i] = OrderBookLevel{
+			Price:     toInt64(o1[fmt.Sprintf("askp%d", n)]),
+			Qty:       toInt64(o1[fmt.Sprintf("askp_rsqn%d", n)]),
+			QtyChange: toInt64(o1[fmt.Sprintf("askp_rsqn_icdc%d", n)]),
+		}
+		ob.Bids[i] = OrderBookLevel{
+			Price:     toInt64(o1[fmt.Sprintf("bidp%d", n)]),
+			Qty:       toInt64(o1[fmt.Sprintf("bidp_rsqn%d", n)]),
+			QtyChange: toInt64(o1[fmt.Sprintf("bidp_rsqn_icdc%d", n)]),
+		}
+	}
+
+	if o2 := data.Output2; o2 != nil {
+		ob.Code = o2.StckShrnIscd
+		ob.Price = toInt64(o2.StckPrpr)
+		ob.ExpectedPrice = toInt64(o2.AntcCnpr)
+		ob.ExpectedQty = toInt64(o2.AntcVol)
+		ob.ExpectedSign = ChangeSign(o2.AntcCntgVrssSign)
+		ob.ExpectedDiff = toInt64(o2.AntcCntgVrss)
+		ob.ExpectedRate = toFloat(o2.AntcCntgPrdyCtrt)
+	}
+
+	return ob, nil
+}
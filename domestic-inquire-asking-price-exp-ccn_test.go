@@ -0,0 +1,65 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomesticOrderBook(t *testing.T) {
+	var fields []string
+	for i := 1; i <= 10; i++ {
+		fields = append(fields,
+			fmt.Sprintf(`"askp%d":"%d"`, i, 71900+i*100),
+			fmt.Sprintf(`"bidp%d":"%d"`, i, 71900-(i-1)*100),
+			fmt.Sprintf(`"askp_rsqn%d":"%d"`, i, i*10),
+			fmt.Sprintf(`"bidp_rsqn%d":"%d"`, i, i*20),
+			fmt.Sprintf(`"askp_rsqn_icdc%d":"-%d"`, i, i),
+			fmt.Sprintf(`"bidp_rsqn_icdc%d":"%d"`, i, i),
+		)
+	}
+	fields = append(fields, `"aspr_acpt_hour":"085959"`, `"total_askp_rsqn":"550"`, `"total_bidp_rsqn":"1100"`)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-asking-price-exp-ccn":
+			assert.Equal(t, "005930", r.URL.Query().Get("fid_input_iscd"))
+			fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output1":{%s},"output2":{"stck_shrn_iscd":"005930","stck_prpr":"71900","antc_cnpr":"72000","antc_vol":"12345","antc_cntg_vrss_sign":"2","antc_cntg_vrss":"100","antc_cntg_prdy_ctrt":"0.14"}}`, strings.Join(fields, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ob, err := c.GetDomesticOrderBook(context.Background(), "005930")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "005930", ob.Code)
+	assert.Equal(t, 8, ob.Time.Hour())
+	assert.Equal(t, OrderBookLevel{Price: 72000, Qty: 10, QtyChange: -1}, ob.Asks[0])
+	assert.Equal(t, OrderBookLevel{Price: 71900, Qty: 20, QtyChange: 1}, ob.Bids[0])
+	assert.Equal(t, int64(72900), ob.Asks[9].Price)
+	assert.Equal(t, int64(71000), ob.Bids[9].Price)
+	assert.Equal(t, int64(72000), ob.ExpectedPrice)
+	assert.Equal(t, int64(12345), ob.ExpectedQty)
+	assert.True(t, ob.ExpectedSign.IsUp())
+
+	assert.Equal(t, int64(100), ob.Spread())
+	assert.Equal(t, 71950.0, ob.Mid())
+	assert.InDelta(t, 1.0/3, ob.Imbalance(), 1e-9)
+
+	empty := &OrderBook{}
+	assert.Equal(t, int64(0), empty.Spread())
+	assert.Equal(t, 0.0, empty.Mid())
+	assert.Equal(t, 0.0, empty.Imbalance())
+}
@@ -5,7 +5,10 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/suapapa/go_kinvest/internal/conv"
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
 
@@ -19,7 +22,7 @@ func (c *Client) GetDomesticInquireCcnl(ctx context.Context, code string) ([]*Do
 		&oapi.GetUapiDomesticStockV1QuotationsInquireCcnlParams{
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
-			TrId:               ptr("FHKST01010300"),
+			TrId:               c.trID("FHKST01010300"),
 		},
 	)
 	if err != nil {
@@ -32,17 +35,33 @@ func (c *Client) GetDomesticInquireCcnl(ctx context.Context, code string) ([]*Do
 		return nil, fmt.Errorf("unmarshal response failed: %w", err)
 	}
 
-	return validateDomesticInquireCcnlResp(respData)
+	return validateDomesticInquireCcnlResp(resp, respData)
 }
 
 type uapiDomesticStockV1QuotationsInquireCcnlResponse struct {
-	Output []*DomesticInquireCcnl `json:"output"`
-	RtCd   string                 `json:"rt_cd"`
-	MsgCd  string                 `json:"msg_cd"`
-	Msg1   string                 `json:"msg1"`
+	Output []*DomesticInquireCcnlRaw `json:"output"`
+	RtCd   string                    `json:"rt_cd"`
+	MsgCd  string                    `json:"msg_cd"`
+	Msg1   string                    `json:"msg1"`
 }
 
+// DomesticInquireCcnl represents 주식현재가 체결 내역 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticInquireCcnl struct {
+	StckCntgHour time.Time  `yaml:"주식체결시간,omitempty"` // 주식 체결 시간
+	StckPrpr     int64      `yaml:"주식현재가,omitempty"`  // 주식 현재가
+	PrdyVrss     int64      `yaml:"전일대비,omitempty"`   // 전일 대비
+	PrdyVrssSign ChangeSign `yaml:"전일대비부호,omitempty"` // 전일 대비 부호
+	CntgVol      int64      `yaml:"체결거래량,omitempty"`  // 체결 거래량
+	TdayRltv     float64    `yaml:"당일체결강도,omitempty"` // 당일 체결강도
+	PrdyCtrt     float64    `yaml:"전일대비율,omitempty"`  // 전일 대비율
+
+	Raw *DomesticInquireCcnlRaw `yaml:"-"`
+}
+
+// DomesticInquireCcnlRaw is 주식현재가 체결 내역 as the API returns, in strings.
+type DomesticInquireCcnlRaw struct {
 	StckCntgHour string `json:"stck_cntg_hour,omitempty" yaml:"주식체결시간,omitempty"` // 주식 체결 시간
 	StckPrpr     string `json:"stck_prpr,omitempty" yaml:"주식현재가,omitempty"`       // 주식 현재가
 	PrdyVrss     string `json:"prdy_vrss,omitempty" yaml:"전일대비,omitempty"`        // 전일 대비
@@ -52,14 +71,41 @@ type DomesticInquireCcnl struct {
 	PrdyCtrt     string `json:"prdy_ctrt,omitempty" yaml:"전일대비율,omitempty"`       // 전일 대비율
 }
 
-func validateDomesticInquireCcnlResp(resp *uapiDomesticStockV1QuotationsInquireCcnlResponse) ([]*DomesticInquireCcnl, error) {
-	if resp.RtCd != "0" {
-		return nil, fmt.Errorf("error response: rt_cd=%s, msg_cd=%s, msg1=%s", resp.RtCd, resp.MsgCd, resp.Msg1)
+func newDomesticInquireCcnl(r *DomesticInquireCcnlRaw) *DomesticInquireCcnl {
+	if r == nil {
+		return nil
 	}
 
-	if resp.Output == nil {
+	cntgHour, _ := conv.HHMMSSToTime(r.StckCntgHour)
+
+	return &DomesticInquireCcnl{
+		StckCntgHour: cntgHour,
+		StckPrpr:     toInt64(r.StckPrpr),
+		PrdyVrss:     toInt64(r.PrdyVrss),
+		PrdyVrssSign: ChangeSign(r.PrdyVrssSign),
+		CntgVol:      toInt64(r.CntgVol),
+		TdayRltv:     toFloat(r.TdayRltv),
+		PrdyCtrt:     toFloat(r.PrdyCtrt),
+		Raw:          r,
+	}
+}
+
+func validateDomesticInquireCcnlResp(resp *http.Response, data *uapiDomesticStockV1QuotationsInquireCcnlResponse) ([]*DomesticInquireCcnl, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return resp.Output, nil
+	ret := make([]*DomesticInquireCcnl, 0, len(data.Output))
+	for _, o := range data.Output {
+		if o == nil {
+			continue
+		}
+		ret = append(ret, newDomesticInquireCcnl(o))
+	}
+
+	return ret, nil
 }
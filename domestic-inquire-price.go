@@ -5,6 +5,8 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -19,7 +21,7 @@ func (c *Client) GetDomesticInquirePrice(ctx context.Context, code string) (*Dom
 		&oapi.GetUapiDomesticStockV1QuotationsInquirePriceParams{
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
-			TrId:               ptr("FHKST01010100"),
+			TrId:               c.trID("FHKST01010100"),
 		},
 	)
 	if err != nil {
@@ -32,17 +34,108 @@ func (c *Client) GetDomesticInquirePrice(ctx context.Context, code string) (*Dom
 		return nil, fmt.Errorf("unmarshal response failed: %w", err)
 	}
 
-	return validateDomesticInquirePriceResp(respData)
+	return validateDomesticInquirePriceResp(resp, respData)
 }
 
 type uapiDomesticStoecV1QuotationsInquirePriceResponse struct {
-	Output *DomesticInquirePrice `json:"output"`
-	RtCd   string                `json:"rt_cd"`
-	MsgCd  string                `json:"msg_cd"`
-	Msg1   string                `json:"msg1"`
+	Output *DomesticInquirePriceRaw `json:"output"`
+	RtCd   string                   `json:"rt_cd"`
+	MsgCd  string                   `json:"msg_cd"`
+	Msg1   string                   `json:"msg1"`
 }
 
+// DomesticInquirePrice represents 주식현재가 시세 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticInquirePrice struct {
+	IscdStatClsCode      string     `yaml:"종목상태구분코드,omitempty"`       // 종목 상태 구분 코드
+	MargRate             float64    `yaml:"증거금비율,omitempty"`          // 증거금 비율
+	RprsMrktKorName      string     `yaml:"대표시장한글명,omitempty"`        // 대표 시장 한글 명
+	NewHgprLwprClsCode   string     `yaml:"신고가저가구분코드,omitempty"`      // 신 고가 저가 구분 코드
+	BstpKorIsnm          string     `yaml:"업종한글종목명,omitempty"`        // 업종 한글 종목명
+	TempStopYn           bool       `yaml:"임시정지여부,omitempty"`         // 임시 정지 여부
+	OprcRangContYn       bool       `yaml:"시가범위연장여부,omitempty"`       // 시가 범위 연장 여부
+	ClprRangContYn       bool       `yaml:"종가범위연장여부,omitempty"`       // 종가 범위 연장 여부
+	CrdtAbleYn           bool       `yaml:"신용가능여부,omitempty"`         // 신용 가능 여부
+	GrmnRateClsCode      string     `yaml:"보증금비율구분코드,omitempty"`      // 보증금 비율 구분 코드
+	ElwPblcYn            bool       `yaml:"ELW발행여부,omitempty"`        // ELW 발행 여부
+	StckPrpr             int64      `yaml:"주식현재가,omitempty"`          // 주식 현재가
+	PrdyVrss             int64      `yaml:"전일대비,omitempty"`           // 전일 대비
+	PrdyVrssSign         ChangeSign `yaml:"전일대비부호,omitempty"`         // 전일 대비 부호
+	PrdyCtrt             float64    `yaml:"전일대비율,omitempty"`          // 전일 대비율
+	AcmlTrPbmn           int64      `yaml:"누적거래대금,omitempty"`         // 누적 거래 대금
+	AcmlVol              int64      `yaml:"누적거래량,omitempty"`          // 누적 거래량
+	PrdyVrssVolRate      float64    `yaml:"전일대비거래량비율,omitempty"`      // 전일 대비 거래량 비율
+	StckOprc             int64      `yaml:"주식시가2,omitempty"`          // 주식 시가2
+	StckHgpr             int64      `yaml:"주식최고가,omitempty"`          // 주식 최고가
+	StckLwpr             int64      `yaml:"주식최저가,omitempty"`          // 주식 최저가
+	StckMxpr             int64      `yaml:"주식상한가,omitempty"`          // 주식 상한가
+	StckLlam             int64      `yaml:"주식하한가,omitempty"`          // 주식 하한가
+	StckSdpr             int64      `yaml:"주식기준가,omitempty"`          // 주식 기준가
+	WghnAvrgStckPrc      int64      `yaml:"가중평균주식가격,omitempty"`       // 가중 평균 주식 가격
+	HtsFrgnEhrt          float64    `yaml:"HTS외국인소진율,omitempty"`      // HTS 외국인 소진율
+	FrgnNtbyQty          int64      `yaml:"외국인순매수수량,omitempty"`       // 외국인 순매수 수량
+	PgtrNtbyQty          int64      `yaml:"프로그램매매순매수수량,omitempty"`    // 프로그램매매 순매수 수량
+	PvtScndDmrsPrc       int64      `yaml:"피벗2차디저항가격,omitempty"`      // 피벗 2차 디저항 가격
+	PvtFrstDmrsPrc       int64      `yaml:"피벗1차디저항가격,omitempty"`      // 피벗 1차 디저항 가격
+	PvtPontVal           int64      `yaml:"피벗포인트값,omitempty"`         // 피벗 포인트 값
+	PvtFrstDmspPrc       int64      `yaml:"피벗1차디지지가격,omitempty"`      // 피벗 1차 디지지 가격
+	PvtScndDmspPrc       int64      `yaml:"피벗2차디지지가격,omitempty"`      // 피벗 2차 디지지 가격
+	DmrsVal              int64      `yaml:"디저항값,omitempty"`           // 디저항 값
+	DmspVal              int64      `yaml:"디지지값,omitempty"`           // 디지지 값
+	Cpfn                 int64      `yaml:"자본금,omitempty"`            // 자본금
+	RstcWdthPrc          int64      `yaml:"제한폭가격,omitempty"`          // 제한 폭 가격
+	StckFcam             int64      `yaml:"주식액면가,omitempty"`          // 주식 액면가
+	StckSspr             int64      `yaml:"주식대용가,omitempty"`          // 주식 대용가
+	AsprUnit             int64      `yaml:"호가단위,omitempty"`           // 호가단위
+	HtsDealQtyUnitVal    int64      `yaml:"HTS매매수량단위값,omitempty"`     // HTS 매매 수량 단위 값
+	LstnStcn             int64      `yaml:"상장주수,omitempty"`           // 상장 주수
+	HtsAvls              int64      `yaml:"HTS시가총액,omitempty"`        // HTS 시가총액
+	Per                  float64    `yaml:"PER,omitempty"`            // PER
+	Pbr                  float64    `yaml:"PBR,omitempty"`            // PBR
+	StacMonth            string     `yaml:"결산월,omitempty"`            // 결산 월
+	VolTnrt              float64    `yaml:"거래량회전율,omitempty"`         // 거래량 회전율
+	Eps                  float64    `yaml:"EPS,omitempty"`            // EPS
+	Bps                  float64    `yaml:"BPS,omitempty"`            // BPS
+	D250Hgpr             int64      `yaml:"250일최고가,omitempty"`        // 250일 최고가
+	D250HgprDate         time.Time  `yaml:"250일최고가일자,omitempty"`      // 250일 최고가 일자
+	D250HgprVrssPrprRate float64    `yaml:"250일최고가대비현재가비율,omitempty"` // 250일 최고가 대비 현재가 비율
+	D250Lwpr             int64      `yaml:"250일최저가,omitempty"`        // 250일 최저가
+	D250LwprDate         time.Time  `yaml:"250일최저가일자,omitempty"`      // 250일 최저가 일자
+	D250LwprVrssPrprRate float64    `yaml:"250일최저가대비현재가비율,omitempty"` // 250일 최저가 대비 현재가 비율
+	StckDryyHgpr         int64      `yaml:"주식연중최고가,omitempty"`        // 주식 연중 최고가
+	DryyHgprVrssPrprRate float64    `yaml:"연중최고가대비현재가비율,omitempty"`   // 연중 최고가 대비 현재가 비율
+	DryyHgprDate         time.Time  `yaml:"연중최고가일자,omitempty"`        // 연중 최고가 일자
+	StckDryyLwpr         int64      `yaml:"주식연중최저가,omitempty"`        // 주식 연중 최저가
+	DryyLwprVrssPrprRate float64    `yaml:"연중최저가대비현재가비율,omitempty"`   // 연중 최저가 대비 현재가 비율
+	DryyLwprDate         time.Time  `yaml:"연중최저가일자,omitempty"`        // 연중 최저가 일자
+	W52Hgpr              int64      `yaml:"52주일최고가,omitempty"`        // 52주일 최고가
+	W52HgprVrssPrprCtrt  float64    `yaml:"52주일최고가대비현재가대비,omitempty"` // 52주일 최고가 대비 현재가 대비
+	W52HgprDate          time.Time  `yaml:"52주일최고가일자,omitempty"`      // 52주일 최고가 일자
+	W52Lwpr              int64      `yaml:"52주일최저가,omitempty"`        // 52주일 최저가
+	W52LwprVrssPrprCtrt  float64    `yaml:"52주일최저가대비현재가대비,omitempty"` // 52주일 최저가 대비 현재가 대비
+	W52LwprDate          time.Time  `yaml:"52주일최저가일자,omitempty"`      // 52주일 최저가 일자
+	WholLoanRmndRate     float64    `yaml:"전체융자잔고비율,omitempty"`       // 전체 융자 잔고 비율
+	SstsYn               bool       `yaml:"공매도가능여부,omitempty"`        // 공매도가능여부
+	StckShrnIscd         string     `yaml:"주식단축종목코드,omitempty"`       // 주식 단축 종목코드
+	FcamCnnm             string     `yaml:"액면가통화명,omitempty"`         // 액면가 통화명
+	CpfnCnnm             string     `yaml:"자본금통화명,omitempty"`         // 자본금 통화명
+	ApprchRate           float64    `yaml:"접근도,omitempty"`            // 접근도
+	FrgnHldnQty          int64      `yaml:"외국인보유수량,omitempty"`        // 외국인 보유 수량
+	ViClsCode            string     `yaml:"VI적용구분코드,omitempty"`       // VI적용구분코드
+	OvtmViClsCode        string     `yaml:"시간외단일가VI적용구분코드,omitempty"` // 시간외단일가VI적용구분코드
+	LastSstsCntgQty      int64      `yaml:"최종공매도체결수량,omitempty"`      // 최종 공매도 체결 수량
+	InvtCafulYn          bool       `yaml:"투자유의여부,omitempty"`         // 투자유의여부
+	MrktWarnClsCode      string     `yaml:"시장경고코드,omitempty"`         // 시장경고코드
+	ShortOverYn          bool       `yaml:"단기과열여부,omitempty"`         // 단기과열여부
+	SltrYn               bool       `yaml:"정리매매여부,omitempty"`         // 정리매매여부
+	MangIssuClsCode      string     `yaml:"관리종목여부,omitempty"`         // 관리종목여부
+
+	Raw *DomesticInquirePriceRaw `yaml:"-"`
+}
+
+// DomesticInquirePriceRaw is 주식현재가 시세 as the API returns, in strings.
+type DomesticInquirePriceRaw struct {
 	IscdStatClsCode      string `json:"iscd_stat_cls_code,omitempty" yaml:"종목상태구분코드,omitempty"`             // 종목 상태 구분 코드
 	MargRate             string `json:"marg_rate,omitempty" yaml:"증거금비율,omitempty"`                         // 증거금 비율
 	RprsMrktKorName      string `json:"rprs_mrkt_kor_name,omitempty" yaml:"대표시장한글명,omitempty"`              // 대표 시장 한글 명
@@ -127,14 +220,106 @@ type DomesticInquirePrice struct {
 	MangIssuClsCode      string `json:"mang_issu_cls_code,omitempty" yaml:"관리종목여부,omitempty"`               // 관리종목여부
 }
 
-func validateDomesticInquirePriceResp(resp *uapiDomesticStoecV1QuotationsInquirePriceResponse) (*DomesticInquirePrice, error) {
-	if resp.RtCd != "0" {
-		return nil, fmt.Errorf("error response: rt_cd=%s, msg_cd=%s, msg1=%s", resp.RtCd, resp.MsgCd, resp.Msg1)
+func newDomesticInquirePrice(r *DomesticInquirePriceRaw) *DomesticInquirePrice {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticInquirePrice{
+		IscdStatClsCode:      r.IscdStatClsCode,
+		MargRate:             toFloat(r.MargRate),
+		RprsMrktKorName:      r.RprsMrktKorName,
+		NewHgprLwprClsCode:   r.NewHgprLwprClsCode,
+		BstpKorIsnm:          r.BstpKorIsnm,
+		TempStopYn:           r.TempStopYn == "Y",
+		OprcRangContYn:       r.OprcRangContYn == "Y",
+		ClprRangContYn:       r.ClprRangContYn == "Y",
+		CrdtAbleYn:           r.CrdtAbleYn == "Y",
+		GrmnRateClsCode:      r.GrmnRateClsCode,
+		ElwPblcYn:            r.ElwPblcYn == "Y",
+		StckPrpr:             toInt64(r.StckPrpr),
+		PrdyVrss:             toInt64(r.PrdyVrss),
+		PrdyVrssSign:         ChangeSign(r.PrdyVrssSign),
+		PrdyCtrt:             toFloat(r.PrdyCtrt),
+		AcmlTrPbmn:           toInt64(r.AcmlTrPbmn),
+		AcmlVol:              toInt64(r.AcmlVol),
+		PrdyVrssVolRate:      toFloat(r.PrdyVrssVolRate),
+		StckOprc:             toInt64(r.StckOprc),
+		StckHgpr:             toInt64(r.StckHgpr),
+		StckLwpr:             toInt64(r.StckLwpr),
+		StckMxpr:             toInt64(r.StckMxpr),
+		StckLlam:             toInt64(r.StckLlam),
+		StckSdpr:             toInt64(r.StckSdpr),
+		WghnAvrgStckPrc:      toInt64(r.WghnAvrgStckPrc),
+		HtsFrgnEhrt:          toFloat(r.HtsFrgnEhrt),
+		FrgnNtbyQty:          toInt64(r.FrgnNtbyQty),
+		PgtrNtbyQty:          toInt64(r.PgtrNtbyQty),
+		PvtScndDmrsPrc:       toInt64(r.PvtScndDmrsPrc),
+		PvtFrstDmrsPrc:       toInt64(r.PvtFrstDmrsPrc),
+		PvtPontVal:           toInt64(r.PvtPontVal),
+		PvtFrstDmspPrc:       toInt64(r.PvtFrstDmspPrc),
+		PvtScndDmspPrc:       toInt64(r.PvtScndDmspPrc),
+		DmrsVal:              toInt64(r.DmrsVal),
+		DmspVal:              toInt64(r.DmspVal),
+		Cpfn:                 toInt64(r.Cpfn),
+		RstcWdthPrc:          toInt64(r.RstcWdthPrc),
+		StckFcam:             toInt64(r.StckFcam),
+		StckSspr:             toInt64(r.StckSspr),
+		AsprUnit:             toInt64(r.AsprUnit),
+		HtsDealQtyUnitVal:    toInt64(r.HtsDealQtyUnitVal),
+		LstnStcn:             toInt64(r.LstnStcn),
+		HtsAvls:              toInt64(r.HtsAvls),
+		Per:                  toFloat(r.Per),
+		Pbr:                  toFloat(r.Pbr),
+		StacMonth:            r.StacMonth,
+		VolTnrt:              toFloat(r.VolTnrt),
+		Eps:                  toFloat(r.Eps),
+		Bps:                  toFloat(r.Bps),
+		D250Hgpr:             toInt64(r.D250Hgpr),
+		D250HgprDate:         toTime(r.D250HgprDate),
+		D250HgprVrssPrprRate: toFloat(r.D250HgprVrssPrprRate),
+		D250Lwpr:             toInt64(r.D250Lwpr),
+		D250LwprDate:         toTime(r.D250LwprDate),
+		D250LwprVrssPrprRate: toFloat(r.D250LwprVrssPrprRate),
+		StckDryyHgpr:         toInt64(r.StckDryyHgpr),
+		DryyHgprVrssPrprRate: toFloat(r.DryyHgprVrssPrprRate),
+		DryyHgprDate:         toTime(r.DryyHgprDate),
+		StckDryyLwpr:         toInt64(r.StckDryyLwpr),
+		DryyLwprVrssPrprRate: toFloat(r.DryyLwprVrssPrprRate),
+		DryyLwprDate:         toTime(r.DryyLwprDate),
+		W52Hgpr:              toInt64(r.W52Hgpr),
+		W52HgprVrssPrprCtrt:  toFloat(r.W52HgprVrssPrprCtrt),
+		W52HgprDate:          toTime(r.W52HgprDate),
+		W52Lwpr:              toInt64(r.W52Lwpr),
+		W52LwprVrssPrprCtrt:  toFloat(r.W52LwprVrssPrprCtrt),
+		W52LwprDate:          toTime(r.W52LwprDate),
+		WholLoanRmndRate:     toFloat(r.WholLoanRmndRate),
+		SstsYn:               r.SstsYn == "Y",
+		StckShrnIscd:         r.StckShrnIscd,
+		FcamCnnm:             r.FcamCnnm,
+		CpfnCnnm:             r.CpfnCnnm,
+		ApprchRate:           toFloat(r.ApprchRate),
+		FrgnHldnQty:          toInt64(r.FrgnHldnQty),
+		ViClsCode:            r.ViClsCode,
+		OvtmViClsCode:        r.OvtmViClsCode,
+		LastSstsCntgQty:      toInt64(r.LastSstsCntgQty),
+		InvtCafulYn:          r.InvtCafulYn == "Y",
+		MrktWarnClsCode:      r.MrktWarnClsCode,
+		ShortOverYn:          r.ShortOverYn == "Y",
+		SltrYn:               r.SltrYn == "Y",
+		MangIssuClsCode:      r.MangIssuClsCode,
+		Raw:                  r,
+	}
+}
+
+func validateDomesticInquirePriceResp(resp *http.Response, data *uapiDomesticStoecV1QuotationsInquirePriceResponse) (*DomesticInquirePrice, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
-	if resp.Output == nil {
+	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return resp.Output, nil
+	return newDomesticInquirePrice(data.Output), nil
 }
@@ -5,6 +5,7 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -19,7 +20,7 @@ func (c *Client) GetDomesticInquirePrice2(ctx context.Context, code string) (*Do
 		&oapi.GetUapiDomesticStockV1QuotationsInquirePrice2Params{
 			FidCondMrktDivCode: ptr("J"), // 시장 구분 코드 (J: 주식)
 			FidInputIscd:       ptr(code),
-			TrId:               ptr("FHPST01010000"),
+			TrId:               c.trID("FHPST01010000"),
 		},
 	)
 	if err != nil {
@@ -32,17 +33,80 @@ func (c *Client) GetDomesticInquirePrice2(ctx context.Context, code string) (*Do
 		return nil, fmt.Errorf("unmarshal response failed: %w", err)
 	}
 
-	return validateDomesticInquirePrice2(respData)
+	return validateDomesticInquirePrice2(resp, respData)
 }
 
 type uapiDomesticStockV1QuotationsInquirePrice2Response struct {
-	Output *DomesticInquirePrice2 `json:"output"`
-	RtCd   string                 `json:"rt_cd"`
-	MsgCd  string                 `json:"msg_cd"`
-	Msg1   string                 `json:"msg1"`
+	Output *DomesticInquirePrice2Raw `json:"output"`
+	RtCd   string                    `json:"rt_cd"`
+	MsgCd  string                    `json:"msg_cd"`
+	Msg1   string                    `json:"msg1"`
 }
 
+// DomesticInquirePrice2 represents 주식현재가 시세2 with typed values.
+// A value that fails to parse is left zero; Raw keeps the original string
+// values of the response to tell it from a real zero.
 type DomesticInquirePrice2 struct {
+	RprsMrktKorName      string     `yaml:"대표시장한글명,omitempty"`     // 대표 시장 한글 명
+	NewHgprLwprClsCode   string     `yaml:"신고가저가구분코드,omitempty"`   // 신 고가 저가 구분 코드
+	MxprLlamClsCode      string     `yaml:"상하한가구분코드,omitempty"`    // 상하한가 구분 코드
+	CrdtAbleYn           bool       `yaml:"신용가능여부,omitempty"`      // 신용 가능 여부
+	StckMxpr             int64      `yaml:"주식상한가,omitempty"`       // 주식 상한가
+	ElwPblcYn            bool       `yaml:"ELW발행여부,omitempty"`     // ELW 발행 여부
+	PrdyClprVrssOprcRate float64    `yaml:"전일종가대비시가2비율,omitempty"` // 전일 종가 대비 시가2 비율
+	CrdtRate             float64    `yaml:"신용비율,omitempty"`        // 신용 비율
+	MargRate             float64    `yaml:"증거금비율,omitempty"`       // 증거금 비율
+	LwprVrssPrpr         int64      `yaml:"최저가대비현재가,omitempty"`    // 최저가 대비 현재가
+	LwprVrssPrprSign     ChangeSign `yaml:"최저가대비현재가부호,omitempty"`  // 최저가 대비 현재가 부호
+	PrdyClprVrssLwprRate float64    `yaml:"전일종가대비최저가비율,omitempty"` // 전일 종가 대비 최저가 비율
+	StckLwpr             int64      `yaml:"주식최저가,omitempty"`       // 주식 최저가
+	HgprVrssPrpr         int64      `yaml:"최고가대비현재가,omitempty"`    // 최고가 대비 현재가
+	HgprVrssPrprSign     ChangeSign `yaml:"최고가대비현재가부호,omitempty"`  // 최고가 대비 현재가 부호
+	PrdyClprVrssHgprRate float64    `yaml:"전일종가대비최고가비율,omitempty"` // 전일 종가 대비 최고가 비율
+	StckHgpr             int64      `yaml:"주식최고가,omitempty"`       // 주식 최고가
+	OprcVrssPrpr         int64      `yaml:"시가2대비현재가,omitempty"`    // 시가2 대비 현재가
+	OprcVrssPrprSign     ChangeSign `yaml:"시가2대비현재가부호,omitempty"`  // 시가2 대비 현재가 부호
+	MangIssuYn           bool       `yaml:"관리종목여부,omitempty"`      // 관리 종목 여부
+	DiviAppClsCode       string     `yaml:"동시호가배분처리코드,omitempty"`  // 동시호가배분처리코드
+	ShortOverYn          bool       `yaml:"단기과열여부,omitempty"`      // 단기과열여부
+	MrktWarnClsCode      string     `yaml:"시장경고코드,omitempty"`      // 시장경고코드
+	InvtCafulYn          bool       `yaml:"투자유의여부,omitempty"`      // 투자유의여부
+	StangeRunupYn        bool       `yaml:"이상급등여부,omitempty"`      // 이상급등여부
+	SstsHotYn            bool       `yaml:"공매도과열여부,omitempty"`     // 공매도과열 여부
+	LowCurrentYn         bool       `yaml:"저유동성종목여부,omitempty"`    // 저유동성 종목 여부
+	ViClsCode            string     `yaml:"VI적용구분코드,omitempty"`    // VI적용구분코드
+	ShortOverClsCode     string     `yaml:"단기과열구분코드,omitempty"`    // 단기과열구분코드
+	StckLlam             int64      `yaml:"주식하한가,omitempty"`       // 주식 하한가
+	NewLstnClsName       string     `yaml:"신규상장구분명,omitempty"`     // 신규 상장 구분 명
+	VlntDealClsName      string     `yaml:"임의매매구분명,omitempty"`     // 임의 매매 구분 명
+	FlngClsName          string     `yaml:"락구분이름,omitempty"`       // 락 구분 이름
+	RevlIssuReasName     string     `yaml:"재평가종목사유명,omitempty"`    // 재평가 종목 사유 명
+	MrktWarnClsName      string     `yaml:"시장경고구분명,omitempty"`     // 시장 경고 구분 명
+	StckSdpr             int64      `yaml:"주식기준가,omitempty"`       // 주식 기준가
+	BstpClsCode          string     `yaml:"업종구분코드,omitempty"`      // 업종 구분 코드
+	StckPrdyClpr         int64      `yaml:"주식전일종가,omitempty"`      // 주식 전일 종가
+	InsnPbntYn           bool       `yaml:"불성실공시여부,omitempty"`     // 불성실 공시 여부
+	FcamModClsName       string     `yaml:"액면가변경구분명,omitempty"`    // 액면가 변경 구분 명
+	StckPrpr             int64      `yaml:"주식현재가,omitempty"`       // 주식 현재가
+	PrdyVrss             int64      `yaml:"전일대비,omitempty"`        // 전일 대비
+	PrdyVrssSign         ChangeSign `yaml:"전일대비부호,omitempty"`      // 전일 대비 부호
+	PrdyCtrt             float64    `yaml:"전일대비율,omitempty"`       // 전일 대비율
+	AcmlTrPbmn           int64      `yaml:"누적거래대금,omitempty"`      // 누적 거래 대금
+	AcmlVol              int64      `yaml:"누적거래량,omitempty"`       // 누적 거래량
+	PrdyVrssVolRate      float64    `yaml:"전일대비거래량비율,omitempty"`   // 전일 대비 거래량 비율
+	BstpKorIsnm          string     `yaml:"업종한글종목명,omitempty"`     // 업종 한글 종목명
+	SltrYn               bool       `yaml:"정리매매여부,omitempty"`      // 정리매매 여부
+	TrhtYn               bool       `yaml:"거래정지여부,omitempty"`      // 거래정지 여부
+	OprcRangContYn       bool       `yaml:"시가범위연장여부,omitempty"`    // 시가 범위 연장 여부
+	VlntFinClsCode       string     `yaml:"임의종료구분코드,omitempty"`    // 임의 종료 구분 코드
+	StckOprc             int64      `yaml:"주식시가2,omitempty"`       // 주식 시가2
+	PrdyVol              int64      `yaml:"전일거래량,omitempty"`       // 전일 거래량
+
+	Raw *DomesticInquirePrice2Raw `yaml:"-"`
+}
+
+// DomesticInquirePrice2Raw is 주식현재가 시세2 as the API returns, in strings.
+type DomesticInquirePrice2Raw struct {
 	RprsMrktKorName      string `json:"rprs_mrkt_kor_name,omitempty" yaml:"대표시장한글명,omitempty"`           // 대표 시장 한글 명
 	NewHgprLwprClsCode   string `json:"new_hgpr_lwpr_cls_code,omitempty" yaml:"신고가저가구분코드,omitempty"`     // 신 고가 저가 구분 코드
 	MxprLlamClsCode      string `json:"mxpr_llam_cls_code,omitempty" yaml:"상하한가구분코드,omitempty"`          // 상하한가 구분 코드
@@ -99,14 +163,78 @@ type DomesticInquirePrice2 struct {
 	PrdyVol              string `json:"prdy_vol,omitempty" yaml:"전일거래량,omitempty"`                       // 전일 거래량
 }
 
-func validateDomesticInquirePrice2(data *uapiDomesticStockV1QuotationsInquirePrice2Response) (*DomesticInquirePrice2, error) {
+func newDomesticInquirePrice2(r *DomesticInquirePrice2Raw) *DomesticInquirePrice2 {
+	if r == nil {
+		return nil
+	}
+
+	return &DomesticInquirePrice2{
+		RprsMrktKorName:      r.RprsMrktKorName,
+		NewHgprLwprClsCode:   r.NewHgprLwprClsCode,
+		MxprLlamClsCode:      r.MxprLlamClsCode,
+		CrdtAbleYn:           r.CrdtAbleYn == "Y",
+		StckMxpr:             toInt64(r.StckMxpr),
+		ElwPblcYn:            r.ElwPblcYn == "Y",
+		PrdyClprVrssOprcRate: toFloat(r.PrdyClprVrssOprcRate),
+		CrdtRate:             toFloat(r.CrdtRate),
+		MargRate:             toFloat(r.MargRate),
+		LwprVrssPrpr:         toInt64(r.LwprVrssPrpr),
+		LwprVrssPrprSign:     ChangeSign(r.LwprVrssPrprSign),
+		PrdyClprVrssLwprRate: toFloat(r.PrdyClprVrssLwprRate),
+		StckLwpr:             toInt64(r.StckLwpr),
+		HgprVrssPrpr:         toInt64(r.HgprVrssPrpr),
+		HgprVrssPrprSign:     ChangeSign(r.HgprVrssPrprSign),
+		PrdyClprVrssHgprRate: toFloat(r.PrdyClprVrssHgprRate),
+		StckHgpr:             toInt64(r.StckHgpr),
+		OprcVrssPrpr:         toInt64(r.OprcVrssPrpr),
+		OprcVrssPrprSign:     ChangeSign(r.OprcVrssPrprSign),
+		MangIssuYn:           r.MangIssuYn == "Y",
+		DiviAppClsCode:       r.DiviAppClsCode,
+		ShortOverYn:          r.ShortOverYn == "Y",
+		MrktWarnClsCode:      r.MrktWarnClsCode,
+		InvtCafulYn:          r.InvtCafulYn == "Y",
+		StangeRunupYn:        r.StangeRunupYn == "Y",
+		SstsHotYn:            r.SstsHotYn == "Y",
+		LowCurrentYn:         r.LowCurrentYn == "Y",
+		ViClsCode:            r.ViClsCode,
+		ShortOverClsCode:     r.ShortOverClsCode,
+		StckLlam:             toInt64(r.StckLlam),
+		NewLstnClsName:       r.NewLstnClsName,
+		VlntDealClsName:      r.VlntDealClsName,
+		FlngClsName:          r.FlngClsName,
+		RevlIssuReasName:     r.RevlIssuReasName,
+		MrktWarnClsName:      r.MrktWarnClsName,
+		StckSdpr:             toInt64(r.StckSdpr),
+		BstpClsCode:          r.BstpClsCode,
+		StckPrdyClpr:         toInt64(r.StckPrdyClpr),
+		InsnPbntYn:           r.InsnPbntYn == "Y",
+		FcamModClsName:       r.FcamModClsName,
+		StckPrpr:             toInt64(r.StckPrpr),
+		PrdyVrss:             toInt64(r.PrdyVrss),
+		PrdyVrssSign:         ChangeSign(r.PrdyVrssSign),
+		PrdyCtrt:             toFloat(r.PrdyCtrt),
+		AcmlTrPbmn:           toInt64(r.AcmlTrPbmn),
+		AcmlVol:              toInt64(r.AcmlVol),
+		PrdyVrssVolRate:      toFloat(r.PrdyVrssVolRate),
+		BstpKorIsnm:          r.BstpKorIsnm,
+		SltrYn:               r.SltrYn == "Y",
+		TrhtYn:               r.TrhtYn == "Y",
+		OprcRangContYn:       r.OprcRangContYn == "Y",
+		VlntFinClsCode:       r.VlntFinClsCode,
+		StckOprc:             toInt64(r.StckOprc),
+		PrdyVol:              toInt64(r.PrdyVol),
+		Raw:                  r,
+	}
+}
+
+func validateDomesticInquirePrice2(resp *http.Response, data *uapiDomesticStockV1QuotationsInquirePrice2Response) (*DomesticInquirePrice2, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response data is nil")
 	}
 
 	if data.RtCd != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data.Msg1, data.MsgCd)
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
-	return data.Output, nil
+	return newDomesticInquirePrice2(data.Output), nil
 }
@@ -0,0 +1,39 @@
+package kinvest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDomesticInquirePrice(t *testing.T) {
+	raw := &DomesticInquirePriceRaw{
+		StckPrpr:     "71900",
+		PrdyVrss:     "-100",
+		PrdyVrssSign: "5",
+		PrdyCtrt:     "-0.14",
+		Per:          "13.45",
+		W52HgprDate:  "20240711",
+		TempStopYn:   "N",
+		SstsYn:       "Y",
+	}
+
+	p := newDomesticInquirePrice(raw)
+	assert.Equal(t, int64(71900), p.StckPrpr)
+	assert.Equal(t, int64(-100), p.PrdyVrss)
+	assert.Equal(t, ChangeSignFall, p.PrdyVrssSign)
+	assert.True(t, p.PrdyVrssSign.IsDown())
+	assert.Equal(t, "하락", p.PrdyVrssSign.String())
+	assert.Equal(t, -0.14, p.PrdyCtrt)
+	assert.Equal(t, 13.45, p.Per)
+	assert.Equal(t, "2024-07-11", p.W52HgprDate.Format("2006-01-02"))
+	assert.False(t, p.TempStopYn)
+	assert.True(t, p.SstsYn)
+	assert.Same(t, raw, p.Raw)
+
+	// 잘못된 값은 0 이 되고 Raw 에 원래 값이 남는다
+	raw.StckPrpr = "N/A"
+	p = newDomesticInquirePrice(raw)
+	assert.Zero(t, p.StckPrpr)
+	assert.Equal(t, "N/A", p.Raw.StckPrpr)
+}
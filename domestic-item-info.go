@@ -5,6 +5,7 @@ package kinvest
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
@@ -19,7 +20,7 @@ func (c *Client) GetDomesticItemInfo(ctx context.Context, code string) (*ItemInf
 		&oapi.GetUapiDomesticStockV1QuotationsSearchInfoParams{
 			PDNO:       ptr(code),
 			PRDTTYPECD: ptr("300"), // 주식
-			TrId:       ptr("CTPF1604R"),
+			TrId:       c.trID("CTPF1604R"),
 		},
 	)
 	if err != nil {
@@ -32,7 +33,7 @@ func (c *Client) GetDomesticItemInfo(ctx context.Context, code string) (*ItemInf
 		return nil, fmt.Errorf("unmarshal response failed: %w", err)
 	}
 
-	return validateDomesticItemInfo(respData)
+	return validateDomesticItemInfo(resp, respData)
 }
 
 type uapiDomesticStockV1QuotationsSearchInfoResponse struct {
@@ -65,14 +66,14 @@ type ItemInfo struct {
 	FrstErlmDt         string `json:"frst_erlm_dt,omitempty" yaml:"최초등록일자,omitempty"`              // 최초등록일자
 }
 
-func validateDomesticItemInfo(resp *uapiDomesticStockV1QuotationsSearchInfoResponse) (*ItemInfo, error) {
-	if resp.RtCd != "0" {
-		return nil, fmt.Errorf("error response: rt_cd=%s, msg_cd=%s, msg1=%s", resp.RtCd, resp.MsgCd, resp.Msg1)
+func validateDomesticItemInfo(resp *http.Response, data *uapiDomesticStockV1QuotationsSearchInfoResponse) (*ItemInfo, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
-	if resp.Output == nil {
+	if data.Output == nil {
 		return nil, fmt.Errorf("no output data")
 	}
 
-	return resp.Output, nil
+	return data.Output, nil
 }
@@ -2,6 +2,7 @@ package kinvest
 
 import (
 	"cmp"
+	"fmt"
 	"os"
 )
 
@@ -10,12 +11,63 @@ const (
 	prodAddr = "https://openapi.koreainvestment.com:9443"
 )
 
+// Environment selects which KIS server the client talks to.
+type Environment string
+
+const (
+	EnvironmentProd Environment = "prod" // 실전투자
+	EnvironmentVTS  Environment = "vts"  // 모의투자
+)
+
+func parseEnvironment(s string) (Environment, error) {
+	switch Environment(s) {
+	case "", EnvironmentProd:
+		return EnvironmentProd, nil
+	case EnvironmentVTS:
+		return EnvironmentVTS, nil
+	default:
+		return "", fmt.Errorf("invalid environment: %s, set one of the following: %s, %s", s, EnvironmentProd, EnvironmentVTS)
+	}
+}
+
+func (e Environment) addr() string {
+	if e == EnvironmentVTS {
+		return vtsAddr
+	}
+	return prodAddr
+}
+
+// trID returns the TR ID to use in the environment.
+// Quotation TR IDs are shared between environments, so only the trading ones
+// are listed in vtsTrIDs.
+func (e Environment) trID(id string) string {
+	if e != EnvironmentVTS {
+		return id
+	}
+	if vid, ok := vtsTrIDs[id]; ok {
+		return vid
+	}
+	return id
+}
+
+// vtsTrIDs maps 실전투자 TR IDs to their 모의투자 counterparts.
+var vtsTrIDs = map[string]string{
+	"TTTC0802U": "VTTC0802U", // 주식 현금 매수 주문
+	"TTTC0801U": "VTTC0801U", // 주식 현금 매도 주문
+	"TTTC0803U": "VTTC0803U", // 주식 정정 취소 주문
+	"TTTC8434R": "VTTC8434R", // 주식 잔고 조회
+	"TTTC8908R": "VTTC8908R", // 매수가능조회
+	"TTTC8001R": "VTTC8001R", // 주식 일별 주문 체결 조회(3개월이내)
+	"CTSC9115R": "VTSC9115R", // 주식 일별 주문 체결 조회(3개월이전)
+}
+
 var (
 	apiEnvs = map[string]string{
 		"APPKEY":     "",
 		"APPSECRET":  "",
 		"ACCOUNT":    "",
 		"TOKEN_PATH": "",
+		"ENV":        "",
 	}
 )
 
@@ -0,0 +1,47 @@
+package kinvest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentTrID(t *testing.T) {
+	assert.Equal(t, "TTTC0802U", EnvironmentProd.trID("TTTC0802U"))
+	assert.Equal(t, "VTTC0802U", EnvironmentVTS.trID("TTTC0802U"))
+	assert.Equal(t, "FHKST01010100", EnvironmentVTS.trID("FHKST01010100"), "quotation TR ID should be shared")
+}
+
+func TestParseEnvironment(t *testing.T) {
+	env, err := parseEnvironment("")
+	assert.NoError(t, err)
+	assert.Equal(t, EnvironmentProd, env)
+
+	env, err = parseEnvironment("vts")
+	assert.NoError(t, err)
+	assert.Equal(t, EnvironmentVTS, env)
+
+	_, err = parseEnvironment("paper")
+	assert.Error(t, err)
+}
+
+func TestUnsupportedInVTS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	c.env = EnvironmentVTS
+	ctx := context.Background()
+
+	_, err := c.GetDomesticAccountBalance(ctx)
+	assert.ErrorContains(t, err, "not supported in")
+	_, err = c.GetSellableQty(ctx, "005930")
+	assert.ErrorContains(t, err, "not supported in")
+	_, err = c.ListCancellableOrders(ctx)
+	assert.ErrorContains(t, err, "not supported in")
+}
@@ -0,0 +1,104 @@
+package kinvest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is the error response of the KIS API.
+// Use errors.As to get it from the errors returned by Client.
+type APIError struct {
+	HTTPStatus int    // HTTP 상태코드
+	TrID       string // 거래ID
+	RtCd       string // 성공 실패 여부, 0: 성공
+	MsgCd      string // 응답코드, e.g. EGW00201
+	Msg1       string // 응답메세지
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("error response: rt_cd=%s, msg_cd=%s, msg1=%s, tr_id=%s, status=%d",
+		e.RtCd, e.MsgCd, e.Msg1, e.TrID, e.HTTPStatus)
+}
+
+// ErrInvalidOrder is returned when the order is rejected by the local validation before it is sent.
+var ErrInvalidOrder = errors.New("invalid order")
+
+// KIS 응답코드
+const (
+	msgCdInvalidToken      = "EGW00121" // 유효하지 않은 token
+	msgCdExpiredToken      = "EGW00123" // 기간이 만료된 token
+	msgCdRateLimited       = "EGW00201" // 초당 거래건수 초과
+	msgCdInsufficientFunds = "APBK0952" // 주문가능금액 초과
+)
+
+// IsRateLimited reports whether err is caused by exceeding the request quota per second.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.MsgCd == msgCdRateLimited || apiErr.HTTPStatus == http.StatusTooManyRequests
+}
+
+// IsInsufficientFunds reports whether err is caused by ordering over the orderable amount.
+func IsInsufficientFunds(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	// 모의투자는 다른 응답코드로 같은 메세지를 준다
+	return apiErr.MsgCd == msgCdInsufficientFunds || strings.Contains(apiErr.Msg1, "주문가능금액")
+}
+
+// IsTokenExpired reports whether err is caused by an expired or invalid access token.
+func IsTokenExpired(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.MsgCd == msgCdExpiredToken || apiErr.MsgCd == msgCdInvalidToken
+}
+
+func newAPIError(resp *http.Response, rtCd, msgCd, msg1 string) *APIError {
+	e := &APIError{
+		RtCd:  rtCd,
+		MsgCd: msgCd,
+		Msg1:  strings.TrimSpace(msg1),
+	}
+	if resp != nil {
+		e.HTTPStatus = resp.StatusCode
+		e.TrID = resp.Header.Get("tr_id")
+		if e.TrID == "" && resp.Request != nil {
+			e.TrID = resp.Request.Header.Get("tr_id")
+		}
+	}
+	return e
+}
+
+// errorFromResponse makes an APIError from the body of a non 200 response.
+// /oauth2 APIs give error_code and error_description instead of msg_cd and msg1.
+func errorFromResponse(resp *http.Response) *APIError {
+	var body struct {
+		RtCd             string `json:"rt_cd"`
+		MsgCd            string `json:"msg_cd"`
+		Msg1             string `json:"msg1"`
+		ErrorCode        string `json:"error_code"`
+		ErrorDescription string `json:"error_description"`
+	}
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err := json.Unmarshal(b, &body); err != nil {
+		return newAPIError(resp, "", "", http.StatusText(resp.StatusCode))
+	}
+
+	if body.MsgCd == "" {
+		body.MsgCd = body.ErrorCode
+	}
+	if body.Msg1 == "" {
+		body.Msg1 = body.ErrorDescription
+	}
+	return newAPIError(resp, body.RtCd, body.MsgCd, body.Msg1)
+}
@@ -0,0 +1,40 @@
+package kinvest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("tr_id", "FHKST01010100")
+	resp := &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}, Request: req}
+
+	err := fmt.Errorf("request failed: %w", newAPIError(resp, "1", "EGW00201", "초당 거래건수를 초과하였습니다."))
+	assert.True(t, IsRateLimited(err))
+	assert.False(t, IsTokenExpired(err))
+	assert.False(t, IsInsufficientFunds(err))
+	assert.Contains(t, err.Error(), "tr_id=FHKST01010100")
+
+	assert.True(t, IsTokenExpired(newAPIError(nil, "1", "EGW00123", "기간이 만료된 token 입니다.")))
+	assert.True(t, IsInsufficientFunds(newAPIError(nil, "1", "APBK0952", "주문가능금액을 초과 했습니다")))
+	assert.False(t, IsRateLimited(fmt.Errorf("other error")))
+}
+
+func TestErrorFromResponse(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"error_description":"접근토큰 발급 잠시 후 다시 시도하세요(1분당 1회)","error_code":"EGW00133"}`)),
+	}
+
+	apiErr := errorFromResponse(resp)
+	assert.Equal(t, http.StatusForbidden, apiErr.HTTPStatus)
+	assert.Equal(t, "EGW00133", apiErr.MsgCd)
+	assert.Contains(t, apiErr.Msg1, "1분당 1회")
+}
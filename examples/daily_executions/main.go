@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kinvest "github.com/suapapa/go_kinvest"
+)
+
+func main() {
+	kc, err := kinvest.NewClient(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx := context.Background()
+	to := time.Now()
+	from := to.AddDate(0, 0, -7)
+
+	res, err := kc.GetDomesticDailyExecutions(ctx, from, to, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("최근 1주일 주문체결 내역:")
+	for e, err := range res.All(ctx) {
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s %s %s(%s) %s %d/%d @ %.0f [%s]\n",
+			e.OrderedAt.Format("2006-01-02 15:04:05"), e.OrderNo, e.Name, e.Code,
+			e.Side, e.FilledQty, e.OrderQty, e.AvgFillPrice, e.Status,
+		)
+	}
+}
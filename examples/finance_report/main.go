@@ -201,16 +201,10 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 	// Fill in the values
 	checklist.Ticker = ticker
 	checklist.Name = report.ItemInfo.PrdtName
-	currPrice, _ := strconv.ParseInt(report.InquirePrice.StckPrpr, 10, 64)
+	currPrice := report.InquirePrice.StckPrpr
 	checklist.CurrPrice = currPrice
 	checklist.Market = report.InquirePrice.RprsMrktKorName
 
-	// Helper function to safely parse float
-	parseFloat := func(s string) float64 {
-		f, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
-		return f
-	}
-
 	// checkListItemName -> condition
 	conditions := map[string]string{
 		"시가총액":           ">=3000_000_000_000", // 3000억 이상
@@ -239,7 +233,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 	for itemName, condition := range conditions {
 		switch itemName {
 		case "시가총액":
-			mktCap, _ := strconv.ParseInt(report.InquirePrice.HtsAvls, 10, 64)
+			mktCap := report.InquirePrice.HtsAvls
 			mktCap *= 100_000_000 // 억 단위
 			item := &CheckListItem{
 				Name:  itemName,
@@ -251,7 +245,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "증거금률":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.MargRate),
+				Value: report.InquirePrice.MargRate,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -259,7 +253,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "52주일최저가대비현재가대비":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.W52LwprVrssPrprCtrt),
+				Value: report.InquirePrice.W52LwprVrssPrprCtrt,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -267,7 +261,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "52주일최고가대비현재가대비":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.W52HgprVrssPrprCtrt),
+				Value: report.InquirePrice.W52HgprVrssPrprCtrt,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -275,7 +269,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "PER":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.Per),
+				Value: report.InquirePrice.Per,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -283,13 +277,13 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "PBR":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.Pbr),
+				Value: report.InquirePrice.Pbr,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
 
 		case "PSR":
-			sps := parseFloat(report.FinancialRatio[0].Sps)
+			sps := report.FinancialRatio[0].Sps
 			var psr float64
 			if sps > 0 {
 				psr = float64(currPrice) / sps
@@ -305,9 +299,9 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 			// PCR = 시가총액 ÷ 영업활동현금흐름
 			// 시가총액 = 주가 × 발행주식수
 			// 영업활동현금흐름 = 당기순이익 + 감가상각비 + 기타 영업활동현금흐름 조정항목
-			netIncome := parseFloat(report.IncomeStatement[0].ThtrNtin)    // 억원 단위
-			depreciation := parseFloat(report.IncomeStatement[0].DeprCost) // 억원 단위
-			sharesOutstanding := parseFloat(report.InquirePrice.LstnStcn)
+			netIncome := report.IncomeStatement[0].ThtrNtin    // 억원 단위
+			depreciation := report.IncomeStatement[0].DeprCost // 억원 단위
+			sharesOutstanding := float64(report.InquirePrice.LstnStcn)
 
 			// 영업활동현금흐름 (억원 단위)
 			operatingCashFlow := netIncome + depreciation
@@ -328,8 +322,8 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 			checklist.CheckList = append(checklist.CheckList, item)
 
 		case "PEG":
-			per := parseFloat(report.InquirePrice.Per)
-			niGrowthRate := parseFloat(report.FinancialRatio[0].NtinInrt)
+			per := report.InquirePrice.Per
+			niGrowthRate := report.FinancialRatio[0].NtinInrt
 			var peg float64
 			if niGrowthRate > 0 {
 				peg = per / niGrowthRate
@@ -344,7 +338,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "ROE":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.FinancialRatio[0].RoeVal),
+				Value: report.FinancialRatio[0].RoeVal,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -352,14 +346,14 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "ROA":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.ProfitRatio[0].CptlNtinRate),
+				Value: report.ProfitRatio[0].CptlNtinRate,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
 
 		case "영업이익률":
-			sales := parseFloat(report.IncomeStatement[0].SaleAccount)
-			op := parseFloat(report.IncomeStatement[0].BsopPrti)
+			sales := report.IncomeStatement[0].SaleAccount
+			op := report.IncomeStatement[0].BsopPrti
 			var opMargin float64
 			if sales > 0 {
 				opMargin = (op / sales) * 100
@@ -374,7 +368,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "순이익률":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.ProfitRatio[0].SaleNtinRate),
+				Value: report.ProfitRatio[0].SaleNtinRate,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -382,7 +376,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "매출액증가율":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.FinancialRatio[0].Grs),
+				Value: report.FinancialRatio[0].Grs,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -390,7 +384,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "순이익증가율":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.FinancialRatio[0].NtinInrt),
+				Value: report.FinancialRatio[0].NtinInrt,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -398,14 +392,14 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "부채비율":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.FinancialRatio[0].LbltRate),
+				Value: report.FinancialRatio[0].LbltRate,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
 
 		case "유동비율":
-			currentAssets := parseFloat(report.BalanceSheet[0].Cras)
-			currentLiabilities := parseFloat(report.BalanceSheet[0].FlowLblt)
+			currentAssets := report.BalanceSheet[0].Cras
+			currentLiabilities := report.BalanceSheet[0].FlowLblt
 			var currentRatio float64
 			if currentLiabilities > 0 {
 				currentRatio = (currentAssets / currentLiabilities) * 100
@@ -420,7 +414,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "유보율":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.FinancialRatio[0].RsrvRate),
+				Value: report.FinancialRatio[0].RsrvRate,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
@@ -437,7 +431,7 @@ func generateChecklist(ticker string, report *FinanceReport) (*StockChecklist, e
 		case "외국인지분율":
 			item := &CheckListItem{
 				Name:  itemName,
-				Value: parseFloat(report.InquirePrice.HtsFrgnEhrt),
+				Value: report.InquirePrice.HtsFrgnEhrt,
 				OkIf:  condition,
 			}
 			checklist.CheckList = append(checklist.CheckList, item)
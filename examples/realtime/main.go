@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	kinvest "github.com/suapapa/go_kinvest"
+	"github.com/suapapa/go_kinvest/realtime"
+)
+
+func main() {
+	kc, err := kinvest.NewClient(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	rc, err := realtime.NewClient(realtime.NewConfig(kc))
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := rc.SubscribeExecutions(ctx, "005930"); err != nil { // 삼성전자
+		panic(err)
+	}
+	if err := rc.SubscribeOrderBook(ctx, "005930"); err != nil {
+		panic(err)
+	}
+	if err := rc.Start(ctx); err != nil {
+		panic(err)
+	}
+	defer rc.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-rc.Executions():
+			fmt.Printf("[체결] %s %s %d (%+d, %.2f%%) 거래량 %d\n",
+				e.Time.Format("15:04:05"), e.Code, e.Price, e.Change, e.ChangeRate, e.Volume)
+		case ob := <-rc.OrderBooks():
+			fmt.Printf("[호가] %s %s 매도 %d(%d) / 매수 %d(%d)\n",
+				ob.Time.Format("15:04:05"), ob.Code,
+				ob.Asks[0].Price, ob.Asks[0].Qty, ob.Bids[0].Price, ob.Bids[0].Qty)
+		case err := <-rc.Errors():
+			fmt.Println("error:", err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package kinvest
+
+import (
+	"fmt"
+	"time"
+)
+
+// Exchange is the exchange code (거래소ID구분코드) to route the domestic stock order.
+type Exchange string
+
+const (
+	ExchangeKRX Exchange = "KRX" // 한국거래소
+	ExchangeNXT Exchange = "NXT" // 넥스트레이드
+	ExchangeSOR Exchange = "SOR" // 최선집행 (Smart Order Routing)
+)
+
+var exchanges = []Exchange{ExchangeKRX, ExchangeNXT, ExchangeSOR}
+
+var exchangeNames = map[Exchange]string{
+	ExchangeKRX: "한국거래소",
+	ExchangeNXT: "넥스트레이드",
+	ExchangeSOR: "SOR",
+}
+
+// ParseExchange returns the Exchange of the code, such as KRX, or the Korean name, such as 넥스트레이드.
+func ParseExchange(name string) (Exchange, error) {
+	for _, e := range exchanges {
+		if string(e) == name || exchangeNames[e] == name {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid exchange: %s, set one of the following: %s", name, joinNames(exchanges))
+}
+
+// String returns the Korean name of the exchange.
+func (e Exchange) String() string {
+	if name, ok := exchangeNames[e]; ok {
+		return name
+	}
+	return string(e)
+}
+
+// MarshalYAML marshals the exchange in its Korean name.
+func (e Exchange) MarshalYAML() (any, error) {
+	return e.String(), nil
+}
+
+func (e Exchange) valid() bool {
+	_, ok := exchangeNames[e]
+	return ok
+}
+
+// MarketSession is a session of the exchange in a trading day.
+// Start and End are the time of the day in KST.
+type MarketSession struct {
+	Exchange Exchange      `yaml:"거래소"`
+	Name     string        `yaml:"세션"`
+	Start    time.Duration `yaml:"시작"`
+	End      time.Duration `yaml:"종료"`
+	Tradable bool          `yaml:"체결가능"` // false 면 주문 접수만 받는다
+}
+
+// String returns the session with its hours, such as "KRX 정규장 (09:00~15:30)".
+func (s *MarketSession) String() string {
+	return fmt.Sprintf("%s %s (%s~%s)", string(s.Exchange), s.Name, clockString(s.Start), clockString(s.End))
+}
+
+// Contains reports whether t is in the session. Weekends are not checked.
+func (s *MarketSession) Contains(t time.Time) bool {
+	d := timeOfDay(t)
+	return d >= s.Start && d < s.End
+}
+
+// 공휴일은 반영하지 않는다
+var marketSessions = []*MarketSession{
+	{ExchangeKRX, "장전 시간외 종가매매", clock(8, 30, 0), clock(8, 40, 0), true},
+	{ExchangeKRX, "동시호가 주문 접수", clock(8, 40, 0), clock(9, 0, 0), false},
+	{ExchangeKRX, "정규장", clock(9, 0, 0), clock(15, 30, 0), true},
+	{ExchangeKRX, "장후 시간외 종가매매", clock(15, 40, 0), clock(16, 0, 0), true},
+	{ExchangeKRX, "시간외 단일가", clock(16, 0, 0), clock(18, 0, 0), true},
+	{ExchangeNXT, "프리마켓", clock(8, 0, 0), clock(8, 50, 0), true},
+	{ExchangeNXT, "메인마켓", clock(9, 0, 30), clock(15, 20, 0), true},
+	{ExchangeNXT, "애프터마켓", clock(15, 30, 0), clock(20, 0, 0), true},
+}
+
+// MarketSessions returns the sessions of the exchange in a trading day in time order.
+// SOR has the sessions of both KRX and NXT.
+func MarketSessions(ex Exchange) []*MarketSession {
+	var ret []*MarketSession
+	for _, s := range marketSessions {
+		if s.Exchange == ex || ex == ExchangeSOR {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// MarketSessionAt returns the session of the exchange at t, or nil if the market is closed.
+// For SOR, the tradable session of KRX is preferred to the one of NXT.
+func MarketSessionAt(ex Exchange, t time.Time) *MarketSession {
+	t = t.In(loc)
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return nil
+	}
+
+	var ret *MarketSession
+	for _, s := range MarketSessions(ex) {
+		if !s.Contains(t) {
+			continue
+		}
+		if ret == nil || (!ret.Tradable && s.Tradable) {
+			ret = s
+		}
+	}
+	return ret
+}
+
+// IsTradable checks if the given time is tradable on the exchange.
+// The second return value describes the session.
+func IsTradable(ex Exchange, t time.Time) (bool, string) {
+	t = t.In(loc)
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false, "주말 - 거래 불가"
+	}
+
+	s := MarketSessionAt(ex, t)
+	if s == nil {
+		return false, "장외시간 - 거래 불가"
+	}
+	return s.Tradable, s.String()
+}
+
+func clock(h, m, s int) time.Duration {
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+}
+
+func timeOfDay(t time.Time) time.Duration {
+	t = t.In(loc)
+	return clock(t.Hour(), t.Minute(), t.Second())
+}
+
+func clockString(d time.Duration) string {
+	h, m, s := int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second)
+	if s != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%02d:%02d", h, m)
+}
@@ -0,0 +1,79 @@
+package kinvest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketSessionAt(t *testing.T) {
+	at := func(hhmmss string) time.Time {
+		tm, err := time.ParseInLocation("20060102150405", "20250616"+hhmmss, loc) // 월요일
+		assert.NoError(t, err)
+		return tm
+	}
+
+	tests := []struct {
+		ex   Exchange
+		t    string
+		name string // 빈 문자열이면 장외
+	}{
+		{ExchangeKRX, "075959", ""},
+		{ExchangeNXT, "080000", "프리마켓"},
+		{ExchangeKRX, "084500", "동시호가 주문 접수"},
+		{ExchangeNXT, "085500", ""},
+		{ExchangeNXT, "090010", ""},
+		{ExchangeNXT, "090030", "메인마켓"},
+		{ExchangeKRX, "120000", "정규장"},
+		{ExchangeNXT, "152500", ""},
+		{ExchangeNXT, "193000", "애프터마켓"},
+		{ExchangeKRX, "193000", ""},
+		{ExchangeSOR, "081000", "프리마켓"},
+		{ExchangeSOR, "120000", "정규장"},
+		{ExchangeSOR, "170000", "시간외 단일가"},
+		{ExchangeSOR, "190000", "애프터마켓"},
+	}
+	for _, tt := range tests {
+		s := MarketSessionAt(tt.ex, at(tt.t))
+		if tt.name == "" {
+			assert.Nil(t, s, "%s %s", tt.ex, tt.t)
+			continue
+		}
+		if assert.NotNil(t, s, "%s %s", tt.ex, tt.t) {
+			assert.Equal(t, tt.name, s.Name, "%s %s", tt.ex, tt.t)
+		}
+	}
+
+	ok, desc := IsTradable(ExchangeNXT, at("090030"))
+	assert.True(t, ok)
+	assert.Equal(t, "NXT 메인마켓 (09:00:30~15:20)", desc)
+
+	// IsKRXTradable 은 예전 설명을 그대로 준다
+	ok, desc = IsKRXTradable(at("084500"))
+	assert.False(t, ok)
+	assert.Equal(t, "동시호가 주문 접수 중 (08:40~09:00) - 체결은 09:00", desc)
+	ok, desc = IsKRXTradable(at("100000"))
+	assert.True(t, ok)
+	assert.Equal(t, "정규장 거래 가능 (09:00~15:30)", desc)
+	ok, desc = IsKRXTradable(at("153500"))
+	assert.False(t, ok)
+	assert.Equal(t, "장외시간 - 거래 불가", desc)
+
+	ok, desc = IsTradable(ExchangeNXT, at("100000").AddDate(0, 0, 5))
+	assert.False(t, ok)
+	assert.Equal(t, "주말 - 거래 불가", desc)
+}
+
+func TestParseExchange(t *testing.T) {
+	ex, err := ParseExchange("NXT")
+	assert.NoError(t, err)
+	assert.Equal(t, ExchangeNXT, ex)
+
+	ex, err = ParseExchange("한국거래소")
+	assert.NoError(t, err)
+	assert.Equal(t, ExchangeKRX, ex)
+
+	_, err = ParseExchange("NYSE")
+	assert.EqualError(t, err, "invalid exchange: NYSE, set one of the following: 한국거래소, 넥스트레이드, SOR")
+}
@@ -0,0 +1,366 @@
+// 국내주식 > 주문/계좌 > 주식일별주문체결조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// GetDomesticDailyExecutions retrieves the orders and their executions between from and to, from the latest.
+// Use GetNext or All of the result to walk through the remaining pages.
+// The executions older than 3 months are served by another TR, so the period across it is queried
+// in two parts and the older part follows the recent one as the next pages.
+func (c *Client) GetDomesticDailyExecutions(ctx context.Context, from, to time.Time, opt *GetDomesticDailyExecutionsOptions) (*GetDomesticDailyExecutionsResult, error) {
+	if opt == nil {
+		var err error
+		opt, err = NewGetDomesticDailyExecutionsOptions("전체", "전체")
+		if err != nil {
+			return nil, fmt.Errorf("create get domestic daily executions option failed: %w", err)
+		}
+	}
+
+	from, to = truncateDate(from), truncateDate(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid period: %s ~ %s", from.Format("20060102"), to.Format("20060102"))
+	}
+
+	// 3개월 이전 내역은 별도의 TR로 조회한다
+	recent := truncateDate(time.Now()).AddDate(0, -3, 0)
+	if !from.Before(recent) || to.Before(recent) {
+		return c.getDomesticDailyExecutions(ctx, from, to, opt)
+	}
+
+	ret, err := c.getDomesticDailyExecutions(ctx, recent, to, opt)
+	if err != nil {
+		return nil, err
+	}
+	ret.olderFrom, ret.olderTo = from, recent.AddDate(0, 0, -1)
+	return ret, nil
+}
+
+func (c *Client) getDomesticDailyExecutions(ctx context.Context, from, to time.Time, opt *GetDomesticDailyExecutionsOptions) (*GetDomesticDailyExecutionsResult, error) {
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	trID := "TTTC8001R"
+	if from.Before(truncateDate(time.Now()).AddDate(0, -3, 0)) {
+		trID = "CTSC9115R"
+	}
+
+	resp, err := c.oc.GetUapiDomesticStockV1TradingInquireDailyCcld(
+		ctx,
+		&oapi.GetUapiDomesticStockV1TradingInquireDailyCcldParams{
+			CANO:         cano,
+			ACNTPRDTCD:   acntprdtcd,
+			INQRSTRTDT:   ptr(toInt(from.Format("20060102"))), // 조회시작일자
+			INQRENDDT:    ptr(toInt(to.Format("20060102"))),   // 조회종료일자
+			INQRDVSN:     ptr(0),                              // 00: 역순, 01: 정순
+			ORDGNOBRNO:   ptr(""),                             // 주문채번지점번호
+			ODNO:         ptr(opt.OrderNo),                    // 주문번호
+			INQRDVSN1:    ptr(""),                             // 공란: 전체, 1: ELW, 2: 프리보드
+			CTXAREAFK100: ptr(opt.CtxAreaFK),
+			CTXAREANK100: ptr(opt.CtxAreaNK),
+			TrId:         c.trID(trID),
+		},
+		withQuery("PDNO", opt.Code),                  // 종목번호, 공란: 전체
+		withQuery("SLL_BUY_DVSN_CD", opt.sideCode()), // 00: 전체, 01: 매도, 02: 매수
+		withQuery("CCLD_DVSN", opt.fillStatusCode()), // 00: 전체, 01: 체결, 02: 미체결
+		withQuery("INQR_DVSN_3", "00"),               // 00: 전체
+		withTrCont(opt.CtxAreaNK != ""),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingInquireDailyCcldResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newGetDomesticDailyExecutionsResult(c, from, to, opt, resp, respData)
+}
+
+// GetDomesticDailyExecutionsOptions represents the filters for retrieving daily executions.
+type GetDomesticDailyExecutionsOptions struct {
+	Code       string `yaml:"종목번호,omitempty"`   // 공란: 전체
+	OrderNo    string `yaml:"주문번호,omitempty"`   // 공란: 전체
+	Side       string `yaml:"매도매수구분,omitempty"` // 전체, 매도, 매수
+	FillStatus string `yaml:"체결구분,omitempty"`   // 전체, 체결, 미체결
+
+	CtxAreaFK, CtxAreaNK string
+}
+
+// NewGetDomesticDailyExecutionsOptions creates a new GetDomesticDailyExecutionsOptions with the specified side and fill status.
+func NewGetDomesticDailyExecutionsOptions(side, fillStatus string) (*GetDomesticDailyExecutionsOptions, error) {
+	if _, ok := executionSideCode[side]; !ok {
+		return nil, fmt.Errorf("invalid side: %s, set one of the following: %s", side, strings.Join(executionSides, ", "))
+	}
+
+	if _, ok := fillStatusCode[fillStatus]; !ok {
+		return nil, fmt.Errorf("invalid fill status: %s, set one of the following: %s", fillStatus, strings.Join(fillStatuses, ", "))
+	}
+
+	return &GetDomesticDailyExecutionsOptions{
+		Side:       side,
+		FillStatus: fillStatus,
+	}, nil
+}
+
+func (o *GetDomesticDailyExecutionsOptions) sideCode() string {
+	if code, ok := executionSideCode[o.Side]; ok {
+		return code
+	}
+	return executionSideCode["전체"]
+}
+
+// executionSides keeps the order of the sides for the error messages.
+var executionSides = []string{"전체", "매도", "매수"}
+
+var executionSideCode = map[string]string{
+	"전체": "00",
+	"매도": "01",
+	"매수": "02",
+}
+
+func (o *GetDomesticDailyExecutionsOptions) fillStatusCode() string {
+	if code, ok := fillStatusCode[o.FillStatus]; ok {
+		return code
+	}
+	return fillStatusCode["전체"]
+}
+
+// fillStatuses keeps the order of the fill statuses for the error messages.
+var fillStatuses = []string{"전체", "체결", "미체결"}
+
+var fillStatusCode = map[string]string{
+	"전체":  "00",
+	"체결":  "01",
+	"미체결": "02",
+}
+
+type uapiDomesticStockV1TradingInquireDailyCcldResponse struct {
+	Output1      []*dailyCcldOutput1 `json:"output1"`
+	Output2      *dailyCcldOutput2   `json:"output2"`
+	CtxAreaFk100 string              `json:"ctx_area_fk100"`
+	CtxAreaNk100 string              `json:"ctx_area_nk100"`
+	RtCd         string              `json:"rt_cd"`
+	MsgCd        string              `json:"msg_cd"`
+	Msg1         string              `json:"msg1"`
+}
+
+type dailyCcldOutput1 struct {
+	OrdDt        string `json:"ord_dt"`          // 주문일자
+	OrdGnoBrno   string `json:"ord_gno_brno"`    // 주문채번지점번호
+	Odno         string `json:"odno"`            // 주문번호
+	OrgnOdno     string `json:"orgn_odno"`       // 원주문번호
+	OrdDvsnName  string `json:"ord_dvsn_name"`   // 주문구분명
+	SllBuyDvsnCd string `json:"sll_buy_dvsn_cd"` // 매도매수구분코드
+	Pdno         string `json:"pdno"`            // 상품번호
+	PrdtName     string `json:"prdt_name"`       // 상품명
+	OrdQty       string `json:"ord_qty"`         // 주문수량
+	OrdUnpr      string `json:"ord_unpr"`        // 주문단가
+	OrdTmd       string `json:"ord_tmd"`         // 주문시각
+	TotCcldQty   string `json:"tot_ccld_qty"`    // 총체결수량
+	AvgPrvs      string `json:"avg_prvs"`        // 평균가
+	CnclYn       string `json:"cncl_yn"`         // 취소여부
+	TotCcldAmt   string `json:"tot_ccld_amt"`    // 총체결금액
+	RmnQty       string `json:"rmn_qty"`         // 잔여수량
+	RjctQty      string `json:"rjct_qty"`        // 거부수량
+	CncCfrmQty   string `json:"cnc_cfrm_qty"`    // 취소확인수량
+	ExcgDvsnCd   string `json:"excg_dvsn_cd"`    // 거래소구분코드
+}
+
+type dailyCcldOutput2 struct {
+	TotOrdQty    string `json:"tot_ord_qty"`    // 총주문수량
+	TotCcldQty   string `json:"tot_ccld_qty"`   // 총체결수량
+	TotCcldAmt   string `json:"tot_ccld_amt"`   // 총체결금액
+	PrsmTlexSmtl string `json:"prsm_tlex_smtl"` // 추정제비용합계
+	PchsAvgPric  string `json:"pchs_avg_pric"`  // 매입평균가격
+}
+
+// Execution represents an order and its executions of a day.
+type Execution struct {
+	OrderNo      string    `yaml:"주문번호"`
+	OrigOrderNo  string    `yaml:"원주문번호,omitempty"`
+	Venue        string    `yaml:"주문채번지점번호"`
+	Code         string    `yaml:"종목번호"`
+	Name         string    `yaml:"종목명"`
+	Side         string    `yaml:"매도매수구분"` // 매도, 매수
+	OrderType    string    `yaml:"주문구분"`
+	OrderQty     int       `yaml:"주문수량"`
+	OrderPrice   int       `yaml:"주문단가,omitempty"`
+	FilledQty    int       `yaml:"총체결수량,omitempty"`
+	AvgFillPrice float64   `yaml:"평균체결가,omitempty"`
+	FilledAmount int       `yaml:"총체결금액,omitempty"`
+	RemainingQty int       `yaml:"잔여수량,omitempty"`
+	CanceledQty  int       `yaml:"취소확인수량,omitempty"`
+	RejectedQty  int       `yaml:"거부수량,omitempty"`
+	Status       string    `yaml:"상태"` // 체결, 부분체결, 미체결, 취소, 거부
+	OrderedAt    time.Time `yaml:"주문시간"`
+}
+
+// ExecutionSummary represents the totals of the queried executions.
+type ExecutionSummary struct {
+	TotalOrderQty     int     `yaml:"총주문수량"`
+	TotalFilledQty    int     `yaml:"총체결수량"`
+	TotalFilledAmount int     `yaml:"총체결금액"`
+	EstimatedFee      int     `yaml:"추정제비용합계"`
+	AvgPurchasePrice  float64 `yaml:"매입평균가격"`
+}
+
+func newExecution(o *dailyCcldOutput1) *Execution {
+	orderedAt, _ := conv.YmdHmsToTime(o.OrdDt, o.OrdTmd)
+	e := &Execution{
+		OrderNo:      o.Odno,
+		OrigOrderNo:  o.OrgnOdno,
+		Venue:        o.OrdGnoBrno,
+		Code:         o.Pdno,
+		Name:         o.PrdtName,
+		Side:         conv.SideNames[o.SllBuyDvsnCd],
+		OrderType:    o.OrdDvsnName,
+		OrderQty:     toInt(o.OrdQty),
+		OrderPrice:   toInt(o.OrdUnpr),
+		FilledQty:    toInt(o.TotCcldQty),
+		AvgFillPrice: toFloat(o.AvgPrvs),
+		FilledAmount: toInt(o.TotCcldAmt),
+		RemainingQty: toInt(o.RmnQty),
+		CanceledQty:  toInt(o.CncCfrmQty),
+		RejectedQty:  toInt(o.RjctQty),
+		OrderedAt:    orderedAt,
+	}
+
+	switch {
+	case e.RejectedQty > 0:
+		e.Status = "거부"
+	case o.CnclYn == "Y" || e.CanceledQty > 0:
+		e.Status = "취소"
+	case e.FilledQty > 0 && e.FilledQty >= e.OrderQty:
+		e.Status = "체결"
+	case e.FilledQty > 0:
+		e.Status = "부분체결"
+	default:
+		e.Status = "미체결"
+	}
+
+	return e
+}
+
+func newGetDomesticDailyExecutionsResult(c *Client, from, to time.Time, opt *GetDomesticDailyExecutionsOptions, resp *http.Response, data *uapiDomesticStockV1TradingInquireDailyCcldResponse) (*GetDomesticDailyExecutionsResult, error) {
+	if data == nil {
+		return nil, fmt.Errorf("response is nil")
+	}
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	ret := &GetDomesticDailyExecutionsResult{
+		c:         c,
+		from:      from,
+		to:        to,
+		opt:       opt,
+		ctxAreaFK: strings.TrimSpace(data.CtxAreaFk100),
+		ctxAreaNK: strings.TrimSpace(data.CtxAreaNk100),
+		hasNext:   hasNextPage(resp),
+	}
+	for _, o := range data.Output1 {
+		if o == nil || o.Odno == "" {
+			continue
+		}
+		ret.Executions = append(ret.Executions, newExecution(o))
+	}
+	if o := data.Output2; o != nil {
+		ret.Summary = &ExecutionSummary{
+			TotalOrderQty:     toInt(o.TotOrdQty),
+			TotalFilledQty:    toInt(o.TotCcldQty),
+			TotalFilledAmount: toInt(o.TotCcldAmt),
+			EstimatedFee:      toInt(o.PrsmTlexSmtl),
+			AvgPurchasePrice:  toFloat(o.PchsAvgPric),
+		}
+	}
+
+	return ret, nil
+}
+
+// GetDomesticDailyExecutionsResult represents a page of daily executions.
+type GetDomesticDailyExecutionsResult struct {
+	c                    *Client
+	from, to             time.Time
+	opt                  *GetDomesticDailyExecutionsOptions
+	ctxAreaFK, ctxAreaNK string
+	hasNext              bool
+	olderFrom, olderTo   time.Time         // 3개월 이전의 남은 기간
+	Executions           []*Execution      `yaml:"executions,omitempty"`
+	Summary              *ExecutionSummary `yaml:"summary,omitempty"`
+}
+
+// HasNext reports whether there are more pages to retrieve with GetNext.
+func (r *GetDomesticDailyExecutionsResult) HasNext() bool {
+	return (r.hasNext && r.ctxAreaNK != "") || !r.olderFrom.IsZero()
+}
+
+// GetNext retrieves the next page of daily executions.
+func (r *GetDomesticDailyExecutionsResult) GetNext(ctx context.Context) (*GetDomesticDailyExecutionsResult, error) {
+	if !r.HasNext() {
+		return nil, fmt.Errorf("no next page")
+	}
+
+	opt := *r.opt
+	if !r.hasNext || r.ctxAreaNK == "" {
+		// 최근 3개월을 다 봤으면 그 이전 기간을 처음부터 조회한다
+		opt.CtxAreaFK, opt.CtxAreaNK = "", ""
+		ret, err := r.c.getDomesticDailyExecutions(ctx, r.olderFrom, r.olderTo, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("get next page failed: %w", err)
+		}
+		return ret, nil
+	}
+
+	opt.CtxAreaFK = r.ctxAreaFK
+	opt.CtxAreaNK = r.ctxAreaNK
+
+	ret, err := r.c.getDomesticDailyExecutions(ctx, r.from, r.to, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("get next page failed: %w", err)
+	}
+	if ret.ctxAreaFK == r.ctxAreaFK && ret.ctxAreaNK == r.ctxAreaNK {
+		return nil, fmt.Errorf("same as previous page")
+	}
+	ret.olderFrom, ret.olderTo = r.olderFrom, r.olderTo
+
+	return ret, nil
+}
+
+// All returns an iterator over the executions of this page and all following pages.
+// The iteration stops at the first error, which is yielded with a nil execution.
+func (r *GetDomesticDailyExecutionsResult) All(ctx context.Context) iter.Seq2[*Execution, error] {
+	return func(yield func(*Execution, error) bool) {
+		for page := r; page != nil; {
+			for _, e := range page.Executions {
+				if !yield(e, nil) {
+					return
+				}
+			}
+			if !page.HasNext() {
+				return
+			}
+
+			next, err := page.GetNext(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			page = next
+		}
+	}
+}
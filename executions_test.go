@@ -0,0 +1,103 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomesticDailyExecutions(t *testing.T) {
+	today := truncateDate(time.Now())
+	from := today.AddDate(0, -4, 0)
+	recent := today.AddDate(0, -3, 0)
+	ymd := func(t time.Time) string { return t.Format("20060102") }
+
+	row := func(odno, qty, filled, cncl, cnfm, rjct string) string {
+		return fmt.Sprintf(`{"ord_dt":"%s","ord_gno_brno":"06010","odno":"%s","sll_buy_dvsn_cd":"02","pdno":"005930","prdt_name":"삼성전자","ord_qty":"%s","tot_ccld_qty":"%s","cncl_yn":"%s","cnc_cfrm_qty":"%s","rjct_qty":"%s","ord_tmd":"090000"}`,
+			ymd(today), odno, qty, filled, cncl, cnfm, rjct)
+	}
+
+	var mu sync.Mutex
+	var reqs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-daily-ccld":
+			q := r.URL.Query()
+			trID, nk := r.Header.Get("tr_id"), q.Get("CTX_AREA_NK100")
+			mu.Lock()
+			reqs = append(reqs, fmt.Sprintf("%s %s~%s %s %s", trID, q.Get("INQR_STRT_DT"), q.Get("INQR_END_DT"), r.Header.Get("tr_cont"), nk))
+			mu.Unlock()
+
+			switch {
+			case trID == "TTTC8001R" && nk == "":
+				w.Header().Set("tr_cont", "F")
+				fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"KIOK0460","msg1":"조회 되었습니다.","ctx_area_fk100":"FK1","ctx_area_nk100":"NK1","output1":[%s,%s],"output2":{"tot_ord_qty":"20"}}`,
+					row("0000000001", "10", "10", "N", "0", "0"), row("0000000002", "10", "3", "N", "0", "0"))
+			case trID == "TTTC8001R" && nk == "NK1":
+				w.Header().Set("tr_cont", "D")
+				fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"KIOK0460","msg1":"조회 되었습니다.","ctx_area_fk100":"FK2","ctx_area_nk100":"NK2","output1":[%s,%s,%s]}`,
+					row("0000000003", "10", "0", "Y", "10", "0"), row("0000000004", "10", "0", "N", "0", "10"), row("0000000005", "10", "0", "N", "0", "0"))
+			case trID == "CTSC9115R":
+				w.Header().Set("tr_cont", "D")
+				fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"KIOK0460","msg1":"조회 되었습니다.","output1":[%s]}`, row("0000000006", "5", "5", "N", "0", "0"))
+			default:
+				t.Errorf("unexpected request: %s %v", trID, q)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	res, err := c.GetDomesticDailyExecutions(ctx, from, today, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, res.Executions, 2)
+	assert.Equal(t, 20, res.Summary.TotalOrderQty)
+	assert.True(t, res.HasNext())
+
+	next, err := res.GetNext(ctx)
+	if assert.NoError(t, err) {
+		assert.Len(t, next.Executions, 3)
+		// 최근 3개월을 다 봐도 그 이전 기간이 남아 있다
+		assert.True(t, next.HasNext())
+	}
+
+	var orderNos, statuses []string
+	for e, err := range res.All(ctx) {
+		if !assert.NoError(t, err) {
+			return
+		}
+		orderNos = append(orderNos, e.OrderNo)
+		statuses = append(statuses, e.Status)
+	}
+	assert.Equal(t, []string{"0000000001", "0000000002", "0000000003", "0000000004", "0000000005", "0000000006"}, orderNos)
+	assert.Equal(t, []string{"체결", "부분체결", "취소", "거부", "미체결", "체결"}, statuses)
+
+	// 3개월 경계를 넘는 기간은 최근 기간과 이전 기간을 다른 TR로 나눠 조회한다
+	recentReq := fmt.Sprintf("TTTC8001R %s~%s", ymd(recent), ymd(today))
+	olderReq := fmt.Sprintf("CTSC9115R %s~%s  ", ymd(from), ymd(recent.AddDate(0, 0, -1)))
+	assert.Equal(t, []string{
+		recentReq + "  ", recentReq + " N NK1", // GetDomesticDailyExecutions, GetNext
+		recentReq + " N NK1", olderReq, // All
+	}, reqs)
+
+	_, err1 := NewGetDomesticDailyExecutionsOptions("매매", "전체")
+	_, err2 := NewGetDomesticDailyExecutionsOptions("매매", "전체")
+	if assert.Error(t, err1) {
+		assert.Equal(t, err1.Error(), err2.Error())
+		assert.Contains(t, err1.Error(), "전체, 매도, 매수")
+	}
+}
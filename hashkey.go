@@ -0,0 +1,77 @@
+// OAuth인증 > Hashkey
+
+package kinvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// GetHashkey returns the hashkey (해쉬키) of the request body.
+// The hashkey header is set to the order requests if ClientConfig.Hashkey is true.
+func (c *Client) GetHashkey(ctx context.Context, body map[string]any) (string, error) {
+	resp, err := c.oc.PostUapiHashkey(
+		ctx,
+		&oapi.PostUapiHashkeyParams{},
+		body,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errorFromResponse(resp)
+	}
+
+	respData := &uapiHashkeyResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return "", fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if respData.Hash == "" {
+		return "", fmt.Errorf("response has no hash")
+	}
+
+	return respData.Hash, nil
+}
+
+type uapiHashkeyResponse struct {
+	Body map[string]any `json:"BODY"` // 요청값
+	Hash string         `json:"HASH"` // 해쉬키
+}
+
+// signBody sets the hashkey header to the POST requests of the APIs.
+// It should be the last editor, after the body is fixed.
+func (c *Client) signBody(ctx context.Context, req *http.Request) error {
+	if !c.hashkey || req.Method != http.MethodPost || req.Body == nil ||
+		strings.HasPrefix(req.URL.Path, "/oauth2/") || req.URL.Path == "/uapi/hashkey" {
+		return nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	var body map[string]any
+	if err := json.Unmarshal(b, &body); err != nil {
+		return fmt.Errorf("failed to decode request body: %w", err)
+	}
+
+	hash, err := c.GetHashkey(ctx, body)
+	if err != nil {
+		return fmt.Errorf("failed to get hashkey: %w", err)
+	}
+	req.Header.Set("hashkey", hash)
+
+	return nil
+}
@@ -3,7 +3,7 @@ package kinvest
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 	"time"
 
 	"github.com/suapapa/go_kinvest/internal/oapi"
@@ -13,13 +13,13 @@ import (
 func (c *Client) GetDomesticHoldings(ctx context.Context, opt *GetDomesticHoldingsOptions) (*GetDomesticHoldingsResult, error) {
 	if opt == nil {
 		var err error
-		opt, err = NewGetDomesticHoldingsOptions("기본", "종목별")
+		opt, err = NewHoldingsOptions(SessionRegular, HoldingsByItem)
 		if err != nil {
 			return nil, fmt.Errorf("create get domestic holdings option failed: %w", err)
 		}
 	}
 
-	cano, acntprdtcd, err := parseAccount(c.account)
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
 	if err != nil {
 		return nil, fmt.Errorf("parse account failed: %w", err)
 	}
@@ -38,7 +38,7 @@ func (c *Client) GetDomesticHoldings(ctx context.Context, opt *GetDomesticHoldin
 			PRCSDVSN:          opt.includePrevTradingCode(),          // 00: 전일매매포함, 01: 전일매매미포함
 			CTXAREAFK100:      ptr(opt.CtxAreaFK),                    // 이전 조회 CTX_AREA_MK100
 			CTXAREANK100:      ptr(opt.CtxAreaNK),                    // 이전 조회 CTX_AREA_MK100
-			TrId:              ptr("TTTC8434R"),
+			TrId:              c.trID("TTTC8434R"),
 		},
 	)
 	if err != nil {
@@ -46,61 +46,156 @@ func (c *Client) GetDomesticHoldings(ctx context.Context, opt *GetDomesticHoldin
 	}
 	defer resp.Body.Close()
 
-	return newGetDomesticHoldingsResult(c, opt, mustUnmarshalJsonBody(resp.Body))
+	respData := &uapiDomesticStockV1TradingInquireBalanceResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newGetDomesticHoldingsResult(c, opt, resp, respData)
 }
 
 // GetDomesticHoldingsOptions represents the options for retrieving domestic stock holdings.
 type GetDomesticHoldingsOptions struct {
-	TradingSessionType    string `yaml:"거래세션유형,omitempty"` // 기본, 시간외단일가, NXT정규장
-	QueryType             string `yaml:"조회구분,omitempty"`   // 대출일별, 종목별
-	IncludeFundSettlement bool   `yaml:"편드결제분포함여부,omitempty"`
-	IncludePrevTrading    bool   `yaml:"전일매매포함,omitempty"`
+	TradingSessionType    TradingSession    `yaml:"거래세션유형,omitempty"`
+	QueryType             HoldingsQueryType `yaml:"조회구분,omitempty"`
+	IncludeFundSettlement bool              `yaml:"편드결제분포함여부,omitempty"`
+	IncludePrevTrading    bool              `yaml:"전일매매포함,omitempty"`
 
 	CtxAreaFK, CtxAreaNK string
 }
 
-// NewGetDomesticHoldingsOptions creates a new GetDomesticHoldingsOptions with the specified trading session type and query type.
-func NewGetDomesticHoldingsOptions(tradingSessionType, queryType string) (*GetDomesticHoldingsOptions, error) {
-	if _, ok := tradingSessionTypeCode[tradingSessionType]; !ok {
-		var tradingSessionTypes []string
-		for k := range tradingSessionTypeCode {
-			tradingSessionTypes = append(tradingSessionTypes, k)
-		}
-		return nil, fmt.Errorf("invalid trading session type: %s, set one of the following: %s", tradingSessionType, strings.Join(tradingSessionTypes, ", "))
+// NewHoldingsOptions creates a new GetDomesticHoldingsOptions with the specified trading session and query type.
+func NewHoldingsOptions(session TradingSession, queryType HoldingsQueryType) (*GetDomesticHoldingsOptions, error) {
+	if !session.valid() {
+		return nil, fmt.Errorf("invalid trading session type: %s, set one of the following: %s", session, joinNames(tradingSessions))
 	}
 
-	if _, ok := queryTypeCode[queryType]; !ok {
-		var queryTypes []string
-		for k := range queryTypeCode {
-			queryTypes = append(queryTypes, k)
-		}
-		return nil, fmt.Errorf("invalid query type: %s, set one of the following: %s", queryType, strings.Join(queryTypes, ", "))
+	if !queryType.valid() {
+		return nil, fmt.Errorf("invalid query type: %s, set one of the following: %s", queryType, joinNames(holdingsQueryTypes))
 	}
 
 	return &GetDomesticHoldingsOptions{
-		TradingSessionType: tradingSessionType,
+		TradingSessionType: session,
 		QueryType:          queryType,
 	}, nil
 }
 
-func (o *GetDomesticHoldingsOptions) tradingSessionTypeCode() *string {
-	if code, ok := tradingSessionTypeCode[o.TradingSessionType]; ok {
-		return code
+// NewGetDomesticHoldingsOptions creates a new GetDomesticHoldingsOptions with the specified trading session type and query type.
+// tradingSessionType and queryType are the Korean names, such as 기본 and 종목별.
+func NewGetDomesticHoldingsOptions(tradingSessionType, queryType string) (*GetDomesticHoldingsOptions, error) {
+	session, err := ParseTradingSession(tradingSessionType)
+	if err != nil {
+		return nil, err
 	}
-	return tradingSessionTypeCode["기본"]
+
+	qt, err := ParseHoldingsQueryType(queryType)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHoldingsOptions(session, qt)
 }
 
-var tradingSessionTypeCode = map[string]*string{
-	"기본":     ptr("N"),
-	"시간외단일가": ptr("Y"),
-	"NXT정규장": ptr("X"),
+func (o *GetDomesticHoldingsOptions) tradingSessionTypeCode() *string {
+	if o.TradingSessionType.valid() {
+		return ptr(string(o.TradingSessionType))
+	}
+	return ptr(string(SessionRegular))
 }
 
 func (o *GetDomesticHoldingsOptions) queryTypeCode() *int {
-	if code, ok := queryTypeCode[o.QueryType]; ok {
-		return code
+	if o.QueryType.valid() {
+		return ptr(toInt(string(o.QueryType)))
+	}
+	return ptr(toInt(string(HoldingsByItem)))
+}
+
+// TradingSession is the trading session code (시간외단일가, 거래소여부) to query the holdings.
+type TradingSession string
+
+const (
+	SessionRegular  TradingSession = "N" // 기본
+	SessionOvertime TradingSession = "Y" // 시간외단일가
+	SessionNXT      TradingSession = "X" // NXT정규장
+)
+
+var tradingSessions = []TradingSession{SessionRegular, SessionOvertime, SessionNXT}
+
+var tradingSessionNames = map[TradingSession]string{
+	SessionRegular:  "기본",
+	SessionOvertime: "시간외단일가",
+	SessionNXT:      "NXT정규장",
+}
+
+// ParseTradingSession returns the TradingSession of the Korean name, such as 기본.
+func ParseTradingSession(name string) (TradingSession, error) {
+	for _, s := range tradingSessions {
+		if tradingSessionNames[s] == name {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("invalid trading session type: %s, set one of the following: %s", name, joinNames(tradingSessions))
+}
+
+// String returns the Korean name of the trading session.
+func (s TradingSession) String() string {
+	if name, ok := tradingSessionNames[s]; ok {
+		return name
 	}
-	return queryTypeCode["종목별"]
+	return string(s)
+}
+
+// MarshalYAML marshals the trading session in its Korean name.
+func (s TradingSession) MarshalYAML() (any, error) {
+	return s.String(), nil
+}
+
+func (s TradingSession) valid() bool {
+	_, ok := tradingSessionNames[s]
+	return ok
+}
+
+// HoldingsQueryType is the query type code (조회구분) of the holdings.
+type HoldingsQueryType string
+
+const (
+	HoldingsByLoanDate HoldingsQueryType = "01" // 대출일별
+	HoldingsByItem     HoldingsQueryType = "02" // 종목별
+)
+
+var holdingsQueryTypes = []HoldingsQueryType{HoldingsByLoanDate, HoldingsByItem}
+
+var holdingsQueryTypeNames = map[HoldingsQueryType]string{
+	HoldingsByLoanDate: "대출일별",
+	HoldingsByItem:     "종목별",
+}
+
+// ParseHoldingsQueryType returns the HoldingsQueryType of the Korean name, such as 종목별.
+func ParseHoldingsQueryType(name string) (HoldingsQueryType, error) {
+	for _, t := range holdingsQueryTypes {
+		if holdingsQueryTypeNames[t] == name {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("invalid query type: %s, set one of the following: %s", name, joinNames(holdingsQueryTypes))
+}
+
+// String returns the Korean name of the query type.
+func (t HoldingsQueryType) String() string {
+	if name, ok := holdingsQueryTypeNames[t]; ok {
+		return name
+	}
+	return string(t)
+}
+
+// MarshalYAML marshals the query type in its Korean name.
+func (t HoldingsQueryType) MarshalYAML() (any, error) {
+	return t.String(), nil
+}
+
+func (t HoldingsQueryType) valid() bool {
+	_, ok := holdingsQueryTypeNames[t]
+	return ok
 }
 
 func (o *GetDomesticHoldingsOptions) includePrevTradingCode() *int {
@@ -110,102 +205,152 @@ func (o *GetDomesticHoldingsOptions) includePrevTradingCode() *int {
 	return prevTradingCode[false]
 }
 
-var queryTypeCode = map[string]*int{
-	"대출일별": ptr(01),
-	"종목별":  ptr(02),
-}
-
 var prevTradingCode = map[bool]*int{
 	true:  ptr(00), // 전일매매포함"
 	false: ptr(01), // 전일매매미포함
 }
 
-func newGetDomesticHoldingsResult(c *Client, opt *GetDomesticHoldingsOptions, data map[string]any) (*GetDomesticHoldingsResult, error) {
+type uapiDomesticStockV1TradingInquireBalanceResponse struct {
+	Output1      []*inquireBalanceOutput1 `json:"output1"`
+	Output2      []*inquireBalanceOutput2 `json:"output2"`
+	CtxAreaFk100 string                   `json:"ctx_area_fk100"`
+	CtxAreaNk100 string                   `json:"ctx_area_nk100"`
+	RtCd         string                   `json:"rt_cd"`
+	MsgCd        string                   `json:"msg_cd"`
+	Msg1         string                   `json:"msg1"`
+}
+
+type inquireBalanceOutput1 struct {
+	Pdno           string `json:"pdno"`              // 상품번호
+	PrdtName       string `json:"prdt_name"`         // 상품명
+	TradDvsnName   string `json:"trad_dvsn_name"`    // 매매구분명
+	BfdyBuyQty     string `json:"bfdy_buy_qty"`      // 전일매수수량
+	BfdySllQty     string `json:"bfdy_sll_qty"`      // 전일매도수량
+	ThdtBuyqty     string `json:"thdt_buyqty"`       // 금일매수수량
+	ThdtSllQty     string `json:"thdt_sll_qty"`      // 금일매도수량
+	HldgQty        string `json:"hldg_qty"`          // 보유수량
+	OrdPsblQty     string `json:"ord_psbl_qty"`      // 주문가능수량
+	PchsAvgPric    string `json:"pchs_avg_pric"`     // 매입평균가격
+	PchsAmt        string `json:"pchs_amt"`          // 매입금액
+	Prpr           string `json:"prpr"`              // 현재가
+	EvluAmt        string `json:"evlu_amt"`          // 평가금액
+	EvluPflsAmt    string `json:"evlu_pfls_amt"`     // 평가손익금액
+	EvluPflsRt     string `json:"evlu_pfls_rt"`      // 평가손익율
+	LoanDt         string `json:"loan_dt"`           // 대출일자
+	LoanAmt        string `json:"loan_amt"`          // 대출금액
+	StlnSlngChgs   string `json:"stln_slng_chgs"`    // 대주매각대금
+	ExpdDt         string `json:"expd_dt"`           // 만기일자
+	FlttRt         string `json:"fltt_rt"`           // 등락율
+	BfdyCprsIcdc   string `json:"bfdy_cprs_icdc"`    // 전일대비증감
+	ItemMgnaRtName string `json:"item_mgna_rt_name"` // 종목증거금율명
+	GrtaRtName     string `json:"grta_rt_name"`      // 보증금율명
+	SbstPric       string `json:"sbst_pric"`         // 대용가격
+	StckLoanUnpr   string `json:"stck_loan_unpr"`    // 주식대출단가
+}
+
+type inquireBalanceOutput2 struct {
+	DncaTotAmt         string `json:"dnca_tot_amt"`           // 예수금총금액
+	NxdyExccAmt        string `json:"nxdy_excc_amt"`          // 익일정산금액
+	PrvsRcdlExccAmt    string `json:"prvs_rcdl_excc_amt"`     // 가수도정산금액
+	CmaEvluAmt         string `json:"cma_evlu_amt"`           // CMA평가금액
+	BfdyBuyAmt         string `json:"bfdy_buy_amt"`           // 전일매수금액
+	ThdtBuyAmt         string `json:"thdt_buy_amt"`           // 금일매수금액
+	NxdyAutoRdptAmt    string `json:"nxdy_auto_rdpt_amt"`     // 익일자동상환금액
+	BfdySllAmt         string `json:"bfdy_sll_amt"`           // 전일매도금액
+	ThdtSllAmt         string `json:"thdt_sll_amt"`           // 금일매도금액
+	D2AutoRdptAmt      string `json:"d2_auto_rdpt_amt"`       // D+2자동상환금액
+	BfdyTlexAmt        string `json:"bfdy_tlex_amt"`          // 전일제비용금액
+	ThdtTlexAmt        string `json:"thdt_tlex_amt"`          // 금일제비용금액
+	TotLoanAmt         string `json:"tot_loan_amt"`           // 총대출금액
+	SctsEvluAmt        string `json:"scts_evlu_amt"`          // 유가평가금액
+	TotEvluAmt         string `json:"tot_evlu_amt"`           // 총평가금액
+	NassAmt            string `json:"nass_amt"`               // 순자산금액
+	FncgGldAutoRdptYn  string `json:"fncg_gld_auto_rdpt_yn"`  // 융자금자동상환여부
+	PchsAmtSmtlAmt     string `json:"pchs_amt_smtl_amt"`      // 매입금액합계금액
+	EvluAmtSmtlAmt     string `json:"evlu_amt_smtl_amt"`      // 평가금액합계금액
+	EvluPflsSmtlAmt    string `json:"evlu_pfls_smtl_amt"`     // 평가손익합계금액
+	TotStlnSlngChgs    string `json:"tot_stln_slng_chgs"`     // 총대주매각대금
+	BfdyTotAsstEvluAmt string `json:"bfdy_tot_asst_evlu_amt"` // 전일총자산평가금액
+	AsstIcdcAmt        string `json:"asst_icdc_amt"`          // 자산증감액
+	AsstIcdcErngRt     string `json:"asst_icdc_erng_rt"`      // 자산증감수익율
+}
+
+func newGetDomesticHoldingsResult(c *Client, opt *GetDomesticHoldingsOptions, resp *http.Response, data *uapiDomesticStockV1TradingInquireBalanceResponse) (*GetDomesticHoldingsResult, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response is nil")
 	}
-	if data["rt_cd"] != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data["msg1"], data["msg_cd"])
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
 	ret := &GetDomesticHoldingsResult{
 		c:         c,
 		opt:       opt,
-		ctxAreaFK: data["ctx_area_fk100"].(string),
-		ctxAreaNK: data["ctx_area_nk100"].(string),
+		ctxAreaFK: data.CtxAreaFk100,
+		ctxAreaNK: data.CtxAreaNk100,
 	}
-	if outputs, ok := data["output1"].([]any); ok {
-		for _, output := range outputs {
-			if output == nil {
-				continue
-			}
-			if outputMap, ok := output.(map[string]any); ok {
-				s := &Stock{
-					Code:              outputMap["pdno"].(string),
-					Name:              outputMap["prdt_name"].(string),
-					OrderType:         outputMap["trad_dvsn_name"].(string), // 매수매도구분
-					PrevBuyQty:        toInt(outputMap["bfdy_buy_qty"]),
-					PrevSellQty:       toInt(outputMap["bfdy_sll_qty"]),
-					TodayBuyQty:       toInt(outputMap["thdt_buyqty"]),
-					TodaySellQty:      toInt(outputMap["thdt_sll_qty"]),
-					HoldingQty:        toInt(outputMap["hldg_qty"]),
-					OrdPossibleQty:    toInt(outputMap["ord_psbl_qty"]),
-					PurchaseAvgPrice:  toFloat(outputMap["pchs_avg_pric"]),
-					PurchaseAmount:    toInt(outputMap["pchs_amt"]),
-					CurrPrice:         toInt(outputMap["prpr"]),
-					EvalAmount:        toInt(outputMap["evlu_amt"]),
-					EvalProfitAmount:  toInt(outputMap["evlu_pfls_amt"]),
-					EvalProfitRate:    toFloat(outputMap["evlu_pfls_rt"]),
-					LoanDate:          toTime(outputMap["loan_dt"]),
-					LoanAmount:        toInt(outputMap["loan_amt"]),
-					ShortSellAmount:   toInt(outputMap["stln_slng_chgs"]),
-					ExpiredDate:       toTime(outputMap["expd_dt"]),
-					ChangeRate:        toFloat(outputMap["fltt_rt"]),
-					PriceDiffFromPrev: toInt(outputMap["bfdy_cprs_icdc"]),
-					MarginRate:        outputMap["item_mgna_rt_name"].(string),
-					GuaranteeRate:     outputMap["grta_rt_name"].(string),
-					SubstitutePrice:   toInt(outputMap["sbst_pric"]),
-					LoanPrice:         toFloat(outputMap["stck_loan_unpr"]),
-				}
-				ret.Holdings = append(ret.Holdings, s)
-			}
+	for _, o := range data.Output1 {
+		if o == nil {
+			continue
 		}
+		ret.Holdings = append(ret.Holdings, &Stock{
+			Code:              o.Pdno,
+			Name:              o.PrdtName,
+			OrderType:         o.TradDvsnName, // 매수매도구분
+			PrevBuyQty:        toInt(o.BfdyBuyQty),
+			PrevSellQty:       toInt(o.BfdySllQty),
+			TodayBuyQty:       toInt(o.ThdtBuyqty),
+			TodaySellQty:      toInt(o.ThdtSllQty),
+			HoldingQty:        toInt(o.HldgQty),
+			OrdPossibleQty:    toInt(o.OrdPsblQty),
+			PurchaseAvgPrice:  toFloat(o.PchsAvgPric),
+			PurchaseAmount:    toInt(o.PchsAmt),
+			CurrPrice:         toInt(o.Prpr),
+			EvalAmount:        toInt(o.EvluAmt),
+			EvalProfitAmount:  toInt(o.EvluPflsAmt),
+			EvalProfitRate:    toFloat(o.EvluPflsRt),
+			LoanDate:          toTime(o.LoanDt),
+			LoanAmount:        toInt(o.LoanAmt),
+			ShortSellAmount:   toInt(o.StlnSlngChgs),
+			ExpiredDate:       toTime(o.ExpdDt),
+			ChangeRate:        toFloat(o.FlttRt),
+			PriceDiffFromPrev: toInt(o.BfdyCprsIcdc),
+			MarginRate:        o.ItemMgnaRtName,
+			GuaranteeRate:     o.GrtaRtName,
+			SubstitutePrice:   toInt(o.SbstPric),
+			LoanPrice:         toFloat(o.StckLoanUnpr),
+		})
 	}
-	if outputs, ok := data["output2"].([]any); ok {
-		for _, output := range outputs {
-			if output == nil {
-				continue
-			}
-			if outputMap, ok := output.(map[string]any); ok {
-				b := &Balance{
-					TotalDeposit:                  toInt(outputMap["dnca_tot_amt"]),
-					NextSettlementAmount:          toInt(outputMap["nxdy_excc_amt"]),
-					TempSettlementAmount:          toInt(outputMap["prvs_rcdl_excc_amt"]),
-					CMAValuationAmount:            toInt(outputMap["cma_evlu_amt"]),
-					PrevBuyAmount:                 toInt(outputMap["bfdy_buy_amt"]),
-					TodayBuyAmount:                toInt(outputMap["thdt_buy_amt"]),
-					NextAutoRepaymentAmount:       toInt(outputMap["nxdy_auto_rdpt_amt"]),
-					PrevSellAmount:                toInt(outputMap["bfdy_sll_amt"]),
-					TodaySellAmount:               toInt(outputMap["thdt_sll_amt"]),
-					D2AutoRepaymentAmount:         toInt(outputMap["d2_auto_rdpt_amt"]),
-					PrevFeeAmount:                 toInt(outputMap["bfdy_tlex_amt"]),
-					TodayFeeAmount:                toInt(outputMap["thdt_tlex_amt"]),
-					TotalLoanAmount:               toInt(outputMap["tot_loan_amt"]),
-					SecuritiesValuationAmount:     toInt(outputMap["scts_evlu_amt"]),
-					TotalValuationAmount:          toInt(outputMap["tot_evlu_amt"]),
-					NetAssetAmount:                toInt(outputMap["nass_amt"]),
-					IsAutoRepaymentForLoan:        outputMap["fncg_gld_auto_rdpt_yn"].(string) == "Y",
-					TotalPurchaseAmount:           toInt(outputMap["pchs_amt_smtl_amt"]),
-					TotalValuationSum:             toInt(outputMap["evlu_amt_smtl_amt"]),
-					TotalUnrealizedPnL:            toInt(outputMap["evlu_pfls_smtl_amt"]),
-					TotalShortSellProceeds:        toInt(outputMap["tot_stln_slng_chgs"]),
-					PrevTotalAssetValuationAmount: toInt(outputMap["bfdy_tot_asst_evlu_amt"]),
-					AssetChangeAmount:             toInt(outputMap["asst_icdc_amt"]),
-					AssetChangeReturnRate:         toFloat(outputMap["asst_icdc_erng_rt"]),
-				}
-				ret.Balances = append(ret.Balances, b)
-			}
+	for _, o := range data.Output2 {
+		if o == nil {
+			continue
 		}
+		ret.Balances = append(ret.Balances, &Balance{
+			TotalDeposit:                  toInt(o.DncaTotAmt),
+			NextSettlementAmount:          toInt(o.NxdyExccAmt),
+			TempSettlementAmount:          toInt(o.PrvsRcdlExccAmt),
+			CMAValuationAmount:            toInt(o.CmaEvluAmt),
+			PrevBuyAmount:                 toInt(o.BfdyBuyAmt),
+			TodayBuyAmount:                toInt(o.ThdtBuyAmt),
+			NextAutoRepaymentAmount:       toInt(o.NxdyAutoRdptAmt),
+			PrevSellAmount:                toInt(o.BfdySllAmt),
+			TodaySellAmount:               toInt(o.ThdtSllAmt),
+			D2AutoRepaymentAmount:         toInt(o.D2AutoRdptAmt),
+			PrevFeeAmount:                 toInt(o.BfdyTlexAmt),
+			TodayFeeAmount:                toInt(o.ThdtTlexAmt),
+			TotalLoanAmount:               toInt(o.TotLoanAmt),
+			SecuritiesValuationAmount:     toInt(o.SctsEvluAmt),
+			TotalValuationAmount:          toInt(o.TotEvluAmt),
+			NetAssetAmount:                toInt(o.NassAmt),
+			IsAutoRepaymentForLoan:        o.FncgGldAutoRdptYn == "Y",
+			TotalPurchaseAmount:           toInt(o.PchsAmtSmtlAmt),
+			TotalValuationSum:             toInt(o.EvluAmtSmtlAmt),
+			TotalUnrealizedPnL:            toInt(o.EvluPflsSmtlAmt),
+			TotalShortSellProceeds:        toInt(o.TotStlnSlngChgs),
+			PrevTotalAssetValuationAmount: toInt(o.BfdyTotAsstEvluAmt),
+			AssetChangeAmount:             toInt(o.AsstIcdcAmt),
+			AssetChangeReturnRate:         toFloat(o.AsstIcdcErngRt),
+		})
 	}
 
 	if len(ret.Holdings) == 0 && len(ret.Balances) == 0 {
@@ -0,0 +1,57 @@
+// Package conv converts the string values of the KIS API.
+// It is shared by kinvest and realtime to keep them parsing the same way.
+package conv
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// KST is the time zone of the KIS API.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// SideNames maps 매도매수구분코드 to its name.
+var SideNames = map[string]string{
+	"01": "매도",
+	"02": "매수",
+}
+
+// ToInt parses s as an int. It returns 0 for an empty or malformed s.
+func ToInt(s string) int {
+	if s == "" {
+		return 0
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return i
+}
+
+// ToFloat parses s as a float64. It returns 0 for an empty or malformed s.
+func ToFloat(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// HHMMSSToTime parses a HHMMSS string as the time of today in KST.
+func HHMMSSToTime(hms string) (time.Time, error) {
+	now := time.Now().In(KST)
+	return YmdHmsToTime(now.Format("20060102"), hms)
+}
+
+// YmdHmsToTime parses a YYYYMMDD and a HHMMSS string as a time in KST.
+func YmdHmsToTime(ymd, hms string) (time.Time, error) {
+	t, err := time.ParseInLocation("20060102150405", ymd+hms, KST)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	return t, nil
+}
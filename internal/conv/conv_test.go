@@ -0,0 +1,29 @@
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToNumber(t *testing.T) {
+	assert.Equal(t, 71900, ToInt("71900"))
+	assert.Equal(t, 0, ToInt(""))
+	assert.Equal(t, 0, ToInt("N/A"))
+	assert.Equal(t, -0.14, ToFloat("-0.14"))
+	assert.Equal(t, 0.0, ToFloat("N/A"))
+}
+
+func TestToTime(t *testing.T) {
+	tm, err := YmdHmsToTime("20250102", "093001")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 9, 30, 1, 0, KST), tm)
+
+	tm, err = HHMMSSToTime("093001")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Now().In(KST).Format("20060102")+"093001", tm.Format("20060102150405"))
+
+	_, err = HHMMSSToTime("0930")
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package kinvesttest
+
+import "net/http"
+
+// KIS 응답코드
+const (
+	msgCdInvalidToken      = "EGW00121" // 유효하지 않은 token
+	msgCdExpiredToken      = "EGW00123" // 기간이 만료된 token
+	msgCdRateLimited       = "EGW00201" // 초당 거래건수 초과
+	msgCdInsufficientFunds = "APBK0952" // 주문가능금액 초과
+	msgCdInvalidAccount    = "OPSQ2000" // 계좌번호 오류
+)
+
+// 가짜 서버에만 있는 응답코드
+const (
+	msgCdInsufficientQty = "TEST0001" // 주문가능수량 초과
+	msgCdInvalidInput    = "TEST0002" // 입력값 오류
+	msgCdNotFound        = "TEST0003" // 지원하지 않는 API 또는 종목
+)
+
+// Fault is the failure injected into the responses of the Server.
+type Fault int
+
+const (
+	FaultRateLimit    Fault = iota + 1 // EGW00201 초당 거래건수 초과
+	FaultTokenExpired                  // EGW00123 기간이 만료된 token
+	FaultServerError                   // HTTP 500 Internal Server Error
+)
+
+type fault struct {
+	path string
+	f    Fault
+	n    int
+}
+
+// InjectFault makes the next n requests to the path fail with f.
+// If path is empty, the requests to any API except /oauth2/revokeP are failed.
+// The faults are applied in the order they are injected.
+func (s *Server) InjectFault(path string, f Fault, n int) {
+	if n <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{path: path, f: f, n: n})
+}
+
+// popFault returns the fault for the request to the path, or 0 if there is none.
+func (s *Server) popFault(path string) Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.path != "" && f.path != path {
+			continue
+		}
+		f.n--
+		if f.n == 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		return f.f
+	}
+	return 0
+}
+
+// KIS 는 오류도 200 으로 응답하기도 하지만, 토큰과 유량 오류는 500 으로 응답한다
+func (f Fault) write(w http.ResponseWriter) {
+	switch f {
+	case FaultRateLimit:
+		writeError(w, http.StatusInternalServerError, msgCdRateLimited, "초당 거래건수를 초과하였습니다.")
+	case FaultTokenExpired:
+		writeError(w, http.StatusInternalServerError, msgCdExpiredToken, "기간이 만료된 token 입니다.")
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,749 @@
+// Package kinvesttest provides a fake KIS API server on httptest to test the code
+// built on kinvest.Client without a real account.
+//
+// The server keeps the cash, holdings and orders of one account in memory
+// and fills the orders against the scripted prices. It serves:
+//   - /oauth2/tokenP, /oauth2/revokeP
+//   - /uapi/hashkey
+//   - /uapi/domestic-stock/v1/quotations/inquire-price
+//   - /uapi/domestic-stock/v1/trading/order-cash
+//   - /uapi/domestic-stock/v1/trading/inquire-balance
+//   - /uapi/domestic-stock/v1/trading/inquire-account-balance
+//
+// There is no fee, tax or settlement. The cash is updated as soon as the order is filled.
+package kinvesttest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	kinvest "github.com/suapapa/go_kinvest"
+)
+
+const (
+	Account   = "12345678-01" // 가짜 서버의 계좌번호
+	AppKey    = "kinvesttest-appkey"
+	AppSecret = "kinvesttest-appsecret"
+
+	// DefaultCash is the cash of the account when the server starts.
+	DefaultCash = 10_000_000
+)
+
+const (
+	pathToken          = "/oauth2/tokenP"
+	pathRevoke         = "/oauth2/revokeP"
+	pathHashkey        = "/uapi/hashkey"
+	pathInquirePrice   = "/uapi/domestic-stock/v1/quotations/inquire-price"
+	pathOrderCash      = "/uapi/domestic-stock/v1/trading/order-cash"
+	pathInquireBalance = "/uapi/domestic-stock/v1/trading/inquire-balance"
+	pathAccountBalance = "/uapi/domestic-stock/v1/trading/inquire-account-balance"
+)
+
+var kst = time.FixedZone("KST", 9*60*60)
+
+// Server is a stateful fake KIS API server.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	token    string
+	tokenSeq int
+	cash     int64
+	buyAmt   int64 // 금일매수금액
+	sellAmt  int64 // 금일매도금액
+	stocks   map[string]*stock
+	holdings map[string]*holding
+	orders   []*Order
+	faults   []*fault
+	requests map[string]int
+}
+
+type stock struct {
+	name   string
+	base   int64   // 기준가, 상하한가의 기준
+	price  int64   // 현재가
+	script []int64 // 다음 시세 조회에 쓸 가격들
+	open   int64
+	high   int64
+	low    int64
+	volume int64
+}
+
+type holding struct {
+	qty       int64 // 보유수량
+	orderable int64 // 주문가능수량, 체결되지 않은 매도 주문만큼 적다
+	amount    int64 // 매입금액
+	buyQty    int64 // 금일매수수량
+	sellQty   int64 // 금일매도수량
+}
+
+// Order is an order received by the Server.
+type Order struct {
+	No          string            // 주문번호
+	Code        string            // 종목코드
+	Side        string            // 매수, 매도
+	Type        kinvest.OrderType // 주문구분
+	Qty         int64             // 주문수량
+	Price       int64             // 주문단가, 시장가는 0
+	FilledPrice int64             // 체결단가, 0 이면 미체결
+	OrderedAt   time.Time
+}
+
+// Filled reports whether the order is filled.
+func (o *Order) Filled() bool {
+	return o.FilledPrice > 0
+}
+
+// NewServer starts a new Server with DefaultCash and no stocks.
+// Close the server at the end of the test.
+func NewServer() *Server {
+	s := &Server{
+		cash:     DefaultCash,
+		stocks:   make(map[string]*stock),
+		holdings: make(map[string]*holding),
+		requests: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathToken, s.handleToken)
+	mux.HandleFunc(pathRevoke, s.handleRevoke)
+	mux.HandleFunc(pathHashkey, s.handleHashkey)
+	mux.HandleFunc(pathInquirePrice, s.api(s.handleInquirePrice))
+	mux.HandleFunc(pathOrderCash, s.api(s.handleOrderCash))
+	mux.HandleFunc(pathInquireBalance, s.api(s.handleInquireBalance))
+	mux.HandleFunc(pathAccountBalance, s.api(s.handleAccountBalance))
+	mux.HandleFunc("/", s.api(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, msgCdNotFound, "kinvesttest: "+r.URL.Path+" is not implemented")
+	}))
+	s.Server = httptest.NewServer(s.count(mux))
+
+	return s
+}
+
+// Config returns the ClientConfig to connect kinvest.Client to the server.
+// The rate limit is disabled and the retry waits only a few milliseconds to keep the tests fast.
+func (s *Server) Config() *kinvest.ClientConfig {
+	return &kinvest.ClientConfig{
+		AppKey:     AppKey,
+		AppSecret:  AppSecret,
+		Account:    Account,
+		RateLimit:  -1,
+		Retry:      &kinvest.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+		TokenStore: kinvest.NewMemoryTokenStore(),
+		HTTPClient: s.Client(),
+		BaseURL:    s.URL,
+	}
+}
+
+// AddStock adds the stock with its prices. The first price is the current price
+// and the base of the price limits (상한가, 하한가).
+// The price moves to the next one each time the stock is quoted, and stays at the last one.
+func (s *Server) AddStock(code, name string, prices ...int64) {
+	if len(prices) == 0 {
+		panic("kinvesttest: no price for " + code)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := prices[0]
+	s.stocks[code] = &stock{
+		name:   name,
+		base:   p,
+		price:  p,
+		script: prices[1:],
+		open:   p,
+		high:   p,
+		low:    p,
+	}
+}
+
+// SetPrice moves the price of the stock and fills the open orders which became marketable.
+func (s *Server) SetPrice(code string, price int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.stocks[code]
+	if !ok {
+		panic("kinvesttest: unknown stock " + code)
+	}
+	s.setPrice(code, st, price)
+}
+
+// SetCash sets the cash (예수금) of the account.
+func (s *Server) SetCash(cash int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cash = cash
+}
+
+// Cash returns the cash of the account.
+// The amount of the open buy orders is not included.
+func (s *Server) Cash() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cash
+}
+
+// SetHolding sets the holding quantity and the average purchase price of the stock.
+func (s *Server) SetHolding(code string, qty, avgPrice int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if qty <= 0 {
+		delete(s.holdings, code)
+		return
+	}
+	s.holdings[code] = &holding{
+		qty:       qty,
+		orderable: qty,
+		amount:    qty * avgPrice,
+	}
+}
+
+// Holding returns the holding quantity of the stock.
+func (s *Server) Holding(code string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if h, ok := s.holdings[code]; ok {
+		return h.qty
+	}
+	return 0
+}
+
+// Orders returns the copies of the received orders in order.
+func (s *Server) Orders() []Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]Order, len(s.orders))
+	for i, o := range s.orders {
+		ret[i] = *o
+	}
+	return ret
+}
+
+// Requests returns the number of the requests to the path, including the failed ones.
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+func (s *Server) count(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		s.mu.Unlock()
+		h.ServeHTTP(w, r)
+	})
+}
+
+// api checks the injected faults and the access token before the handler.
+func (s *Server) api(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if f := s.popFault(r.URL.Path); f != 0 {
+			f.write(w)
+			return
+		}
+
+		s.mu.Lock()
+		token := s.token
+		s.mu.Unlock()
+		if token == "" || r.Header.Get("authorization") != "Bearer "+token {
+			writeError(w, http.StatusInternalServerError, msgCdInvalidToken, "유효하지 않은 token 입니다.")
+			return
+		}
+
+		h(w, r)
+	}
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if f := s.popFault(r.URL.Path); f != 0 {
+		f.write(w)
+		return
+	}
+
+	var body map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil ||
+		body["appkey"] != AppKey || body["appsecret"] != AppSecret {
+		writeJSON(w, http.StatusForbidden, map[string]any{
+			"error_code":        "EGW00103",
+			"error_description": "유효하지 않은 AppKey입니다.",
+		})
+		return
+	}
+
+	s.mu.Lock()
+	s.tokenSeq++
+	s.token = fmt.Sprintf("kinvesttest-token-%d", s.tokenSeq)
+	token := s.token
+	s.mu.Unlock()
+
+	expiresIn := 24 * time.Hour
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token":               token,
+		"token_type":                 "Bearer",
+		"expires_in":                 int(expiresIn.Seconds()),
+		"access_token_token_expired": time.Now().Add(expiresIn).In(kst).Format("2006-01-02 15:04:05"),
+	})
+}
+
+func (s *Server) handleRevoke(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.token = ""
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"code":    http.StatusOK,
+		"message": "접근토큰 폐기에 성공하였습니다",
+	})
+}
+
+func (s *Server) handleHashkey(w http.ResponseWriter, r *http.Request) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, msgCdInvalidInput, "kinvesttest: invalid body: "+err.Error())
+		return
+	}
+
+	var body map[string]any
+	json.Unmarshal(b, &body)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"BODY": body,
+		"HASH": hashkey(b),
+	})
+}
+
+func (s *Server) handleInquirePrice(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("FID_INPUT_ISCD")
+	if code == "" {
+		code = r.URL.Query().Get("fid_input_iscd")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.stocks[code]
+	if !ok {
+		writeError(w, http.StatusOK, msgCdNotFound, "kinvesttest: unknown stock "+code)
+		return
+	}
+	if len(st.script) > 0 {
+		s.setPrice(code, st, st.script[0])
+		st.script = st.script[1:]
+	}
+
+	upper, lower := st.limits()
+	diff := st.price - st.base
+	writeOK(w, "MCA00000", "정상처리 되었습니다.", map[string]any{
+		"output": map[string]string{
+			"rprs_mrkt_kor_name": "KOSPI200",
+			"stck_prpr":          itoa(st.price),
+			"prdy_vrss":          itoa(diff),
+			"prdy_vrss_sign":     sign(diff),
+			"prdy_ctrt":          fmt.Sprintf("%.2f", float64(diff)*100/float64(st.base)),
+			"acml_vol":           itoa(st.volume),
+			"stck_oprc":          itoa(st.open),
+			"stck_hgpr":          itoa(st.high),
+			"stck_lwpr":          itoa(st.low),
+			"stck_mxpr":          itoa(upper),
+			"stck_llam":          itoa(lower),
+			"stck_sdpr":          itoa(st.base),
+			"aspr_unit":          strconv.Itoa(kinvest.TickSize(int(st.price))),
+			"temp_stop_yn":       "N",
+			"crdt_able_yn":       "Y",
+		},
+	})
+}
+
+func (s *Server) handleOrderCash(w http.ResponseWriter, r *http.Request) {
+	var side string
+	switch r.Header.Get("tr_id") {
+	case "TTTC0802U", "VTTC0802U":
+		side = "매수"
+	case "TTTC0801U", "VTTC0801U":
+		side = "매도"
+	default:
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: invalid tr_id "+r.Header.Get("tr_id"))
+		return
+	}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: invalid body: "+err.Error())
+		return
+	}
+	if hash := r.Header.Get("hashkey"); hash != "" && hash != hashkey(b) {
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: hashkey mismatch")
+		return
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(b, &body); err != nil {
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: invalid body: "+err.Error())
+		return
+	}
+	if !isAccount(str(body["CANO"]), str(body["ACNT_PRDT_CD"])) {
+		writeError(w, http.StatusOK, msgCdInvalidAccount, "ERROR : INPUT INVALID_CHECK_ACNO")
+		return
+	}
+
+	code := str(body["PDNO"])
+	orderType := kinvest.OrderType(str(body["ORD_DVSN"]))
+	qty, _ := strconv.ParseInt(str(body["ORD_QTY"]), 10, 64)
+	price, _ := strconv.ParseInt(str(body["ORD_UNPR"]), 10, 64)
+	if qty <= 0 || price < 0 {
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: invalid qty or price")
+		return
+	}
+	if orderType.IsMarket() {
+		price = 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.stocks[code]
+	if !ok {
+		writeError(w, http.StatusOK, msgCdNotFound, "kinvesttest: unknown stock "+code)
+		return
+	}
+	if upper, lower := st.limits(); price > 0 && (price > upper || price < lower) {
+		writeError(w, http.StatusOK, msgCdInvalidInput, "kinvesttest: price out of the limits")
+		return
+	}
+
+	switch side {
+	case "매수":
+		// 체결 전까지 주문금액을 묶어 둔다
+		amount := qty * st.price
+		if price > 0 {
+			amount = qty * price
+		}
+		if amount > s.cash {
+			writeError(w, http.StatusOK, msgCdInsufficientFunds, "주문가능금액을 초과 했습니다")
+			return
+		}
+		s.cash -= amount
+	case "매도":
+		h, ok := s.holdings[code]
+		if !ok || h.orderable < qty {
+			writeError(w, http.StatusOK, msgCdInsufficientQty, "주문가능수량을 초과 했습니다")
+			return
+		}
+		h.orderable -= qty
+	}
+
+	now := time.Now().In(kst)
+	o := &Order{
+		No:        fmt.Sprintf("%010d", len(s.orders)+1),
+		Code:      code,
+		Side:      side,
+		Type:      orderType,
+		Qty:       qty,
+		Price:     price,
+		OrderedAt: now,
+	}
+	s.orders = append(s.orders, o)
+	s.match(code, st)
+
+	writeOK(w, "APBK0013", "주문 전송 완료 되었습니다.", map[string]any{
+		"output": map[string]string{
+			"KRX_FWDG_ORD_ORGNO": "91252",
+			"ODNO":               o.No,
+			"ORD_TMD":            now.Format("150405"),
+		},
+	})
+}
+
+func (s *Server) handleInquireBalance(w http.ResponseWriter, r *http.Request) {
+	if !isAccount(r.URL.Query().Get("CANO"), r.URL.Query().Get("ACNT_PRDT_CD")) {
+		writeError(w, http.StatusOK, msgCdInvalidAccount, "ERROR : INPUT INVALID_CHECK_ACNO")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output1 := []map[string]string{}
+	var pchsAmt, evluAmt int64
+	for _, code := range s.holdingCodes() {
+		h, st := s.holdings[code], s.stocks[code]
+		price, name := int64(0), ""
+		if st != nil {
+			price, name = st.price, st.name
+		}
+		evlu := h.qty * price
+		pchsAmt += h.amount
+		evluAmt += evlu
+		output1 = append(output1, map[string]string{
+			"pdno":              code,
+			"prdt_name":         name,
+			"trad_dvsn_name":    "현금",
+			"bfdy_buy_qty":      "0",
+			"bfdy_sll_qty":      "0",
+			"thdt_buyqty":       itoa(h.buyQty),
+			"thdt_sll_qty":      itoa(h.sellQty),
+			"hldg_qty":          itoa(h.qty),
+			"ord_psbl_qty":      itoa(h.orderable),
+			"pchs_avg_pric":     fmt.Sprintf("%.4f", float64(h.amount)/float64(h.qty)),
+			"pchs_amt":          itoa(h.amount),
+			"prpr":              itoa(price),
+			"evlu_amt":          itoa(evlu),
+			"evlu_pfls_amt":     itoa(evlu - h.amount),
+			"evlu_pfls_rt":      rate(evlu-h.amount, h.amount),
+			"evlu_erng_rt":      rate(evlu-h.amount, h.amount),
+			"loan_dt":           "",
+			"loan_amt":          "0",
+			"stln_slng_chgs":    "0",
+			"expd_dt":           "",
+			"fltt_rt":           "0.00",
+			"bfdy_cprs_icdc":    "0",
+			"item_mgna_rt_name": "",
+			"grta_rt_name":      "",
+			"sbst_pric":         "0",
+			"stck_loan_unpr":    "0",
+		})
+	}
+
+	total := s.cash + evluAmt
+	writeOK(w, "KIOK0510", "조회가 완료되었습니다", map[string]any{
+		"ctx_area_fk100": "",
+		"ctx_area_nk100": "",
+		"output1":        output1,
+		"output2": []map[string]string{{
+			"dnca_tot_amt":           itoa(s.cash),
+			"nxdy_excc_amt":          itoa(s.cash),
+			"prvs_rcdl_excc_amt":     itoa(s.cash),
+			"cma_evlu_amt":           "0",
+			"bfdy_buy_amt":           "0",
+			"thdt_buy_amt":           itoa(s.buyAmt),
+			"nxdy_auto_rdpt_amt":     "0",
+			"bfdy_sll_amt":           "0",
+			"thdt_sll_amt":           itoa(s.sellAmt),
+			"d2_auto_rdpt_amt":       "0",
+			"bfdy_tlex_amt":          "0",
+			"thdt_tlex_amt":          "0",
+			"tot_loan_amt":           "0",
+			"scts_evlu_amt":          itoa(evluAmt),
+			"tot_evlu_amt":           itoa(total),
+			"nass_amt":               itoa(total),
+			"fncg_gld_auto_rdpt_yn":  "N",
+			"pchs_amt_smtl_amt":      itoa(pchsAmt),
+			"evlu_amt_smtl_amt":      itoa(evluAmt),
+			"evlu_pfls_smtl_amt":     itoa(evluAmt - pchsAmt),
+			"tot_stln_slng_chgs":     "0",
+			"bfdy_tot_asst_evlu_amt": itoa(total),
+			"asst_icdc_amt":          "0",
+			"asst_icdc_erng_rt":      "0.00000000",
+		}},
+	})
+}
+
+func (s *Server) handleAccountBalance(w http.ResponseWriter, r *http.Request) {
+	if !isAccount(r.URL.Query().Get("CANO"), r.URL.Query().Get("ACNT_PRDT_CD")) {
+		writeError(w, http.StatusOK, msgCdInvalidAccount, "ERROR : INPUT INVALID_CHECK_ACNO")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pchsAmt, evluAmt int64
+	for code, h := range s.holdings {
+		pchsAmt += h.amount
+		if st, ok := s.stocks[code]; ok {
+			evluAmt += h.qty * st.price
+		}
+	}
+	total := s.cash + evluAmt
+
+	// 자산 구분 20개 중 주식, 예수금+CMA, 합계만 채운다
+	item := func(pchs, evlu int64) map[string]string {
+		return map[string]string{
+			"pchs_amt":      itoa(pchs),
+			"evlu_amt":      itoa(evlu),
+			"evlu_pfls_amt": itoa(evlu - pchs),
+			"crdt_lnd_amt":  "0",
+			"real_nass_amt": itoa(evlu),
+			"whol_weit_rt":  rate(evlu, total),
+		}
+	}
+	output1 := make([]map[string]string, 20)
+	for i := range output1 {
+		output1[i] = item(0, 0)
+	}
+	output1[0] = item(pchsAmt, evluAmt)
+	output1[17] = item(s.cash, s.cash)
+	output1[19] = item(pchsAmt+s.cash, total)
+
+	writeOK(w, "KIOK0510", "조회가 완료되었습니다", map[string]any{
+		"output1": output1,
+		"output2": map[string]string{
+			"pchs_amt_smtl":      itoa(pchsAmt + s.cash),
+			"nass_tot_amt":       itoa(total),
+			"loan_amt_smtl":      "0",
+			"evlu_pfls_amt_smtl": itoa(evluAmt - pchsAmt),
+			"evlu_amt_smtl":      itoa(total),
+			"tot_asst_amt":       itoa(total),
+			"tot_dncl_amt":       itoa(s.cash),
+			"dncl_amt":           itoa(s.cash),
+			"cma_evlu_amt":       "0",
+			"tot_sbst_amt":       "0",
+			"thdt_rcvb_amt":      "0",
+		},
+	})
+}
+
+// setPrice should be called with s.mu locked.
+func (s *Server) setPrice(code string, st *stock, price int64) {
+	st.price = price
+	st.high = max(st.high, price)
+	st.low = min(st.low, price)
+	s.match(code, st)
+}
+
+// match fills the open orders of the stock at the current price.
+// It should be called with s.mu locked.
+func (s *Server) match(code string, st *stock) {
+	p := st.price
+	for _, o := range s.orders {
+		if o.Code != code || o.Filled() {
+			continue
+		}
+
+		switch o.Side {
+		case "매수":
+			if o.Price > 0 && p > o.Price {
+				continue
+			}
+			// 묶어 둔 주문금액과 체결금액의 차이를 돌려준다
+			reserved := o.Qty * o.Price
+			if o.Price == 0 {
+				reserved = o.Qty * p
+			}
+			s.cash += reserved - o.Qty*p
+			s.buyAmt += o.Qty * p
+
+			h, ok := s.holdings[code]
+			if !ok {
+				h = &holding{}
+				s.holdings[code] = h
+			}
+			h.qty += o.Qty
+			h.orderable += o.Qty
+			h.amount += o.Qty * p
+			h.buyQty += o.Qty
+		case "매도":
+			if o.Price > 0 && p < o.Price {
+				continue
+			}
+			s.cash += o.Qty * p
+			s.sellAmt += o.Qty * p
+
+			h := s.holdings[code]
+			h.amount -= h.amount * o.Qty / h.qty
+			h.qty -= o.Qty
+			h.sellQty += o.Qty
+			if h.qty == 0 {
+				delete(s.holdings, code)
+			}
+		}
+
+		o.FilledPrice = p
+		st.volume += o.Qty
+	}
+}
+
+func (s *Server) holdingCodes() []string {
+	codes := make([]string, 0, len(s.holdings))
+	for code := range s.holdings {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// limits returns the upper and lower price limits, ±30% of the base price on the tick grid.
+func (st *stock) limits() (upper, lower int64) {
+	upper = int64(kinvest.RoundToTick(int(st.base*13/10), kinvest.RoundDown))
+	lower = int64(kinvest.RoundToTick(int((st.base*7+9)/10), kinvest.RoundUp))
+	return upper, lower
+}
+
+// hashkey returns the hash of the body. It is not the same as the KIS one.
+func hashkey(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func isAccount(cano, prdtCd string) bool {
+	return cano+"-"+prdtCd == Account
+}
+
+func writeOK(w http.ResponseWriter, msgCd, msg1 string, data map[string]any) {
+	data["rt_cd"] = "0"
+	data["msg_cd"] = msgCd
+	data["msg1"] = msg1
+	writeJSON(w, http.StatusOK, data)
+}
+
+func writeError(w http.ResponseWriter, status int, msgCd, msg1 string) {
+	writeJSON(w, status, map[string]any{
+		"rt_cd":  "1",
+		"msg_cd": msgCd,
+		"msg1":   msg1,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func str(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func itoa(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func rate(v, base int64) string {
+	if base == 0 {
+		return "0.00"
+	}
+	return fmt.Sprintf("%.2f", float64(v)*100/float64(base))
+}
+
+// sign returns the prdy_vrss_sign of the change. 2: 상승, 3: 보합, 5: 하락
+func sign(diff int64) string {
+	switch {
+	case diff > 0:
+		return "2"
+	case diff < 0:
+		return "5"
+	default:
+		return "3"
+	}
+}
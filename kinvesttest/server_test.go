@@ -0,0 +1,129 @@
+package kinvesttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kinvest "github.com/suapapa/go_kinvest"
+)
+
+func newClient(t *testing.T, s *Server) *kinvest.Client {
+	t.Helper()
+
+	c, err := kinvest.NewClient(s.Config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestServerOrders(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddStock("005930", "삼성전자", 70000)
+	s.SetCash(1_000_000)
+
+	c := newClient(t, s)
+	ctx := context.Background()
+
+	_, err := c.BuyDomesticStock(ctx, "005930", 10, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), s.Holding("005930"))
+	assert.Equal(t, int64(300_000), s.Cash())
+
+	// 현재가보다 높은 지정가 매도는 가격이 오를 때까지 체결되지 않는다
+	opt, err := kinvest.NewSellOrderOptions(kinvest.OrderTypeLimit, kinvest.SellTypeNormal, 75000)
+	assert.NoError(t, err)
+	_, err = c.SellDomesticStock(ctx, "005930", 4, opt)
+	assert.NoError(t, err)
+	assert.False(t, s.Orders()[1].Filled())
+
+	s.SetPrice("005930", 76000)
+	assert.Equal(t, int64(76000), s.Orders()[1].FilledPrice)
+	assert.Equal(t, int64(6), s.Holding("005930"))
+	assert.Equal(t, int64(604_000), s.Cash())
+
+	holdings, err := c.GetDomesticHoldings(ctx, nil)
+	if assert.NoError(t, err) && assert.Len(t, holdings.Holdings, 1) {
+		h := holdings.Holdings[0]
+		assert.Equal(t, "삼성전자", h.Name)
+		assert.Equal(t, 6, h.HoldingQty)
+		assert.Equal(t, 420_000, h.PurchaseAmount)
+		assert.Equal(t, 456_000, h.EvalAmount)
+		assert.Equal(t, 604_000, holdings.Balances[0].TotalDeposit)
+		assert.Equal(t, 1_060_000, holdings.Balances[0].TotalValuationAmount)
+	}
+
+	bal, err := c.GetDomesticAccountBalance(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1_060_000, bal.TotAsstAmt)
+		assert.Equal(t, 604_000, bal.DnclAmt)
+		assert.Equal(t, 456_000, bal.Items["주식"].EvluAmt)
+	}
+
+	_, err = c.BuyDomesticStock(ctx, "005930", 100, nil)
+	assert.True(t, kinvest.IsInsufficientFunds(err), "%v", err)
+}
+
+func TestServerHashkey(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddStock("005930", "삼성전자", 70000)
+
+	config := s.Config()
+	config.Hashkey = true
+	c, err := kinvest.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.BuyDomesticStock(context.Background(), "005930", 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.Requests(pathHashkey))
+	assert.Equal(t, int64(1), s.Holding("005930"))
+}
+
+func TestServerScriptedPrices(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddStock("000660", "SK하이닉스", 200000, 201000, 199000)
+
+	c := newClient(t, s)
+	ctx := context.Background()
+
+	for _, want := range []int64{201000, 199000, 199000} {
+		p, err := c.GetDomesticInquirePrice(ctx, "000660")
+		if assert.NoError(t, err) {
+			assert.Equal(t, want, p.StckPrpr)
+			assert.Equal(t, int64(260000), p.StckMxpr)
+			assert.Equal(t, int64(140000), p.StckLlam)
+		}
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddStock("005930", "삼성전자", 70000)
+
+	c := newClient(t, s)
+	ctx := context.Background()
+
+	// 조회는 재시도로 복구된다
+	s.InjectFault(pathInquirePrice, FaultRateLimit, 1)
+	s.InjectFault(pathInquirePrice, FaultServerError, 1)
+	_, err := c.GetDomesticInquirePrice(ctx, "005930")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, s.Requests(pathInquirePrice))
+
+	s.InjectFault(pathInquirePrice, FaultRateLimit, 3)
+	_, err = c.GetDomesticInquirePrice(ctx, "005930")
+	assert.True(t, kinvest.IsRateLimited(err), "%v", err)
+
+	// 주문은 재시도하지 않는다
+	s.InjectFault(pathOrderCash, FaultTokenExpired, 1)
+	_, err = c.BuyDomesticStock(ctx, "005930", 1, nil)
+	assert.True(t, kinvest.IsTokenExpired(err), "%v", err)
+	assert.Empty(t, s.Orders())
+}
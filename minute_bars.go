@@ -0,0 +1,234 @@
+// 국내주식 > 기본시세 > 주식당일분봉조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// 정규장 시간
+const (
+	sessionOpenHMS  = "090000"
+	sessionCloseHMS = "153000"
+)
+
+// GetDomesticMinuteBars retrieves the 1 minute bars of the stock in the regular session (09:00 ~ 15:30 KST) of date, in time order.
+// KIS only serves the bars of today, so date should be today. During the session, the bars are up to the current minute.
+// The minutes without any trade are filled with the flat bars of the previous close and zero volume,
+// and the minutes before the first trade with the flat bars of its open.
+// TradeValue of each bar is the trade value in the minute.
+// Use ResampleMinuteBars to get 3, 5, 10 or 30 minute bars.
+func (c *Client) GetDomesticMinuteBars(ctx context.Context, code string, date time.Time) ([]*Candle, error) {
+	return c.getDomesticMinuteBars(ctx, code, date, time.Now())
+}
+
+// getDomesticMinuteBars is GetDomesticMinuteBars at the time now.
+func (c *Client) getDomesticMinuteBars(ctx context.Context, code string, date, now time.Time) ([]*Candle, error) {
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+
+	now = now.In(loc)
+	day := truncateDate(date)
+	if !day.Equal(truncateDate(now)) {
+		return nil, fmt.Errorf("minute bars are only available for today: %s", day.Format("20060102"))
+	}
+	sessionOpen, _ := conv.YmdHmsToTime(day.Format("20060102"), sessionOpenHMS)
+	sessionEnd, _ := conv.YmdHmsToTime(day.Format("20060102"), sessionCloseHMS)
+	if cur := now.Truncate(time.Minute); cur.Before(sessionEnd) {
+		sessionEnd = cur
+	}
+	if sessionEnd.Before(sessionOpen) {
+		// 장 시작 전
+		return nil, nil
+	}
+
+	// 한 번에 30개씩, 입력 시각 이전의 분봉이 최근 것부터 내려온다
+	var bars []*Candle
+	end := sessionEnd
+	for {
+		resp, err := c.oc.GetUapiDomesticStockV1QuotationsInquireTimeItemchartprice(
+			ctx,
+			&oapi.GetUapiDomesticStockV1QuotationsInquireTimeItemchartpriceParams{
+				FIDETCCLSCODE:      ptr(""),
+				FIDCONDMRKTDIVCODE: ptr("J"), // 시장 분류 코드 (J: 주식)
+				FIDPWDATAINCUYN:    ptr(toStr(false)),
+				TrId:               c.trID("FHKST03010200"),
+			},
+			withQuery("FID_INPUT_ISCD", code),
+			withQuery("FID_INPUT_HOUR_1", end.Format("150405")),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		respData := &uapiDomesticStockV1QuotationsInquireTimeItemchartpriceResponse{}
+		err = unmarshalJsonBody(resp.Body, respData)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal response failed: %w", err)
+		}
+
+		page, err := validateDomesticMinuteBars(resp, respData)
+		if err != nil {
+			return nil, err
+		}
+		bars = append(bars, page...)
+
+		if len(page) == 0 {
+			break
+		}
+		oldest := page[len(page)-1].Time
+		if !oldest.After(sessionOpen) {
+			break
+		}
+		next := oldest.Add(-time.Minute)
+		if !next.Before(end) {
+			break
+		}
+		end = next
+	}
+
+	return fillMinuteBars(bars, sessionOpen, sessionEnd), nil
+}
+
+// fillMinuteBars sorts the bars, given from the latest, in time order and fills the missing minutes from `from` to `to`.
+// TradeValue of the given bars is the accumulated value of the day and converted to the value in each minute.
+func fillMinuteBars(bars []*Candle, from, to time.Time) []*Candle {
+	var ret []*Candle
+	var last *Candle
+	var acml int64
+	for i := len(bars) - 1; i >= 0; i-- {
+		bar := bars[i]
+		if last != nil && !bar.Time.After(last.Time) {
+			// 페이지 경계에서 겹친 분봉
+			continue
+		}
+		last = bar
+
+		value := max(bar.TradeValue-acml, 0)
+		acml = max(acml, bar.TradeValue)
+		bar.TradeValue = value
+
+		if bar.Time.Before(from) || bar.Time.After(to) {
+			continue
+		}
+
+		// 첫 체결 전은 첫 분봉의 시가로, 이후는 직전 종가로 채운다
+		t, price := from, bar.Open
+		if n := len(ret); n > 0 {
+			t, price = ret[n-1].Time.Add(time.Minute), ret[n-1].Close
+		}
+		for ; t.Before(bar.Time); t = t.Add(time.Minute) {
+			ret = append(ret, flatMinuteBar(t, price))
+		}
+		ret = append(ret, bar)
+	}
+
+	if n := len(ret); n > 0 {
+		price := ret[n-1].Close
+		for t := ret[n-1].Time.Add(time.Minute); !t.After(to); t = t.Add(time.Minute) {
+			ret = append(ret, flatMinuteBar(t, price))
+		}
+	}
+	return ret
+}
+
+func flatMinuteBar(t time.Time, price int64) *Candle {
+	return &Candle{
+		Time:  t,
+		Open:  price,
+		High:  price,
+		Low:   price,
+		Close: price,
+	}
+}
+
+// ResampleMinuteBars merges the 1 minute bars into the bars of the given minutes, aligned to 09:00 KST.
+// bars should be in time order, as returned by GetDomesticMinuteBars.
+func ResampleMinuteBars(bars []*Candle, minutes int) ([]*Candle, error) {
+	if minutes <= 0 {
+		return nil, fmt.Errorf("invalid minutes: %d", minutes)
+	}
+	d := time.Duration(minutes) * time.Minute
+
+	var ret []*Candle
+	var cur *Candle
+	for _, bar := range bars {
+		day := truncateDate(bar.Time)
+		open := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, loc)
+		start := open.Add(bar.Time.Sub(open) / d * d)
+		if bar.Time.Before(open) {
+			start = bar.Time
+		}
+
+		if cur == nil || !cur.Time.Equal(start) {
+			cur = &Candle{
+				Time: start,
+				Open: bar.Open,
+				High: bar.High,
+				Low:  bar.Low,
+			}
+			ret = append(ret, cur)
+		}
+		cur.High = max(cur.High, bar.High)
+		cur.Low = min(cur.Low, bar.Low)
+		cur.Close = bar.Close
+		cur.Volume += bar.Volume
+		cur.TradeValue += bar.TradeValue
+	}
+
+	return ret, nil
+}
+
+type uapiDomesticStockV1QuotationsInquireTimeItemchartpriceResponse struct {
+	Output2 []*timeItemchartpriceOutput `json:"output2"`
+	RtCd    string                      `json:"rt_cd"`
+	MsgCd   string                      `json:"msg_cd"`
+	Msg1    string                      `json:"msg1"`
+}
+
+type timeItemchartpriceOutput struct {
+	StckBsopDate string `json:"stck_bsop_date"` // 주식영업일자
+	StckCntgHour string `json:"stck_cntg_hour"` // 주식체결시간
+	StckPrpr     string `json:"stck_prpr"`      // 주식현재가
+	StckOprc     string `json:"stck_oprc"`      // 주식시가
+	StckHgpr     string `json:"stck_hgpr"`      // 주식최고가
+	StckLwpr     string `json:"stck_lwpr"`      // 주식최저가
+	CntgVol      string `json:"cntg_vol"`       // 체결거래량
+	AcmlTrPbmn   string `json:"acml_tr_pbmn"`   // 누적거래대금
+}
+
+func validateDomesticMinuteBars(resp *http.Response, data *uapiDomesticStockV1QuotationsInquireTimeItemchartpriceResponse) ([]*Candle, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	var ret []*Candle
+	for _, o := range data.Output2 {
+		if o == nil || o.StckBsopDate == "" || o.StckCntgHour == "" {
+			continue
+		}
+		t, err := conv.YmdHmsToTime(o.StckBsopDate, o.StckCntgHour)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time: %s %s", o.StckBsopDate, o.StckCntgHour)
+		}
+		ret = append(ret, &Candle{
+			Time:       t,
+			Open:       toInt64(o.StckOprc),
+			High:       toInt64(o.StckHgpr),
+			Low:        toInt64(o.StckLwpr),
+			Close:      toInt64(o.StckPrpr),
+			Volume:     toInt64(o.CntgVol),
+			TradeValue: toInt64(o.AcmlTrPbmn), // 누적, fillMinuteBars 에서 분당 거래대금으로 바꾼다
+		})
+	}
+
+	return ret, nil
+}
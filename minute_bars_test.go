@@ -0,0 +1,109 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomesticMinuteBars(t *testing.T) {
+	today := truncateDate(time.Now())
+	ymd := today.Format("20060102")
+
+	// 09:00 ~ 15:30 사이에 09:00, 09:01, 10:00 ~ 10:04, 15:30 은 체결이 없다
+	// 거래대금은 하루 누적으로 분당 1100 씩 는다
+	var hours []string
+	acml := map[string]int64{}
+	var value int64
+	for t := today.Add(9 * time.Hour); t.Before(today.Add(15*time.Hour + 30*time.Minute)); t = t.Add(time.Minute) {
+		if t.Hour() == 9 && t.Minute() < 2 || t.Hour() == 10 && t.Minute() < 5 {
+			continue
+		}
+		hms := t.Format("150405")
+		value += 1100
+		hours = append(hours, hms)
+		acml[hms] = value
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-time-itemchartprice":
+			q := r.URL.Query()
+			assert.Equal(t, "005930", q.Get("FID_INPUT_ISCD"))
+			end := q.Get("FID_INPUT_HOUR_1")
+
+			var rows []string
+			for i := len(hours) - 1; i >= 0 && len(rows) < 30; i-- {
+				if hours[i] > end {
+					continue
+				}
+				rows = append(rows, fmt.Sprintf(`{"stck_bsop_date":"%s","stck_cntg_hour":"%s","stck_prpr":"110","stck_oprc":"100","stck_hgpr":"120","stck_lwpr":"90","cntg_vol":"10","acml_tr_pbmn":"%d"}`, ymd, hours[i], acml[hours[i]]))
+			}
+			fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output2":[%s]}`, strings.Join(rows, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	bars, err := c.getDomesticMinuteBars(context.Background(), "005930", today, today.Add(16*time.Hour))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// 09:00 ~ 15:30, 391 분
+	if !assert.Len(t, bars, 391) {
+		return
+	}
+	for i, bar := range bars {
+		assert.Equal(t, today.Add(9*time.Hour+time.Duration(i)*time.Minute), bar.Time)
+	}
+	first := bars[0] // 09:00, 첫 체결의 시가로 채운다
+	assert.Equal(t, int64(100), first.Open)
+	assert.Equal(t, int64(100), first.Close)
+	assert.Equal(t, int64(0), first.Volume)
+	assert.Equal(t, int64(0), first.TradeValue)
+	assert.Equal(t, int64(1100), bars[2].TradeValue)
+	assert.Equal(t, int64(1100), bars[200].TradeValue)
+	gap := bars[60] // 10:00
+	assert.Equal(t, int64(110), gap.Open)
+	assert.Equal(t, int64(110), gap.Close)
+	assert.Equal(t, int64(0), gap.Volume)
+	assert.Equal(t, int64(0), gap.TradeValue)
+	last := bars[390] // 15:30
+	assert.Equal(t, int64(110), last.Open)
+	assert.Equal(t, int64(0), last.Volume)
+
+	bars5, err := ResampleMinuteBars(bars, 5)
+	if assert.NoError(t, err) && assert.Len(t, bars5, 79) {
+		assert.Equal(t, int64(30), bars5[0].Volume) // 09:02 ~ 09:04
+		assert.Equal(t, int64(3300), bars5[0].TradeValue)
+		assert.Equal(t, today.Add(9*time.Hour+5*time.Minute), bars5[1].Time)
+		assert.Equal(t, int64(100), bars5[1].Open)
+		assert.Equal(t, int64(120), bars5[1].High)
+		assert.Equal(t, int64(90), bars5[1].Low)
+		assert.Equal(t, int64(110), bars5[1].Close)
+		assert.Equal(t, int64(50), bars5[1].Volume)
+		assert.Equal(t, int64(5500), bars5[1].TradeValue)
+		assert.Equal(t, int64(0), bars5[12].Volume) // 10:00 ~ 10:04
+	}
+
+	// 장중에는 현재 분까지
+	bars, err = c.getDomesticMinuteBars(context.Background(), "005930", today, today.Add(11*time.Hour+30*time.Second))
+	if assert.NoError(t, err) && assert.Len(t, bars, 121) {
+		assert.Equal(t, today.Add(11*time.Hour), bars[120].Time)
+		assert.Equal(t, int64(1100), bars[120].TradeValue)
+	}
+
+	_, err = c.GetDomesticMinuteBars(context.Background(), "005930", today.AddDate(0, 0, -1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,279 @@
+// 국내주식 > 주문/계좌 > 주식주문(신용), 신용매수가능조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// BuyDomesticStockOnCredit buys domestic(KRX) stock on credit (신용매수).
+// credit.CreditType should be a loan-new (융자신규) or short-repay (대주상환) type.
+// It is not supported in VTS.
+func (c *Client) BuyDomesticStockOnCredit(ctx context.Context, code string, qty int, credit *CreditOrderOptions, opt *OrderDomesticStockOptions) (*OrderResult, error) {
+	if opt == nil {
+		var err error
+		opt, err = NewBuyOrderOptions(OrderTypeMarket, 0)
+		if err != nil {
+			return nil, fmt.Errorf("create buy option failed: %w", err)
+		}
+	}
+
+	return c.orderCredit(ctx, "TTTC0852U", creditBuyTypes, code, qty, credit, opt)
+}
+
+// SellDomesticStockOnCredit sells domestic(KRX) stock on credit (신용매도).
+// credit.CreditType should be a loan-repay (융자상환) or short-new (대주신규) type.
+// It is not supported in VTS.
+func (c *Client) SellDomesticStockOnCredit(ctx context.Context, code string, qty int, credit *CreditOrderOptions, opt *OrderDomesticStockOptions) (*OrderResult, error) {
+	if opt == nil {
+		var err error
+		opt, err = NewSellOrderOptions(OrderTypeMarket, SellTypeNormal, 0)
+		if err != nil {
+			return nil, fmt.Errorf("create sell option failed: %w", err)
+		}
+	}
+
+	return c.orderCredit(ctx, "TTTC0851U", creditSellTypes, code, qty, credit, opt)
+}
+
+func (c *Client) orderCredit(ctx context.Context, trID string, allowed []CreditType, code string, qty int, credit *CreditOrderOptions, opt *OrderDomesticStockOptions) (*OrderResult, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("credit order is not supported in %s", c.env)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(creditProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	if credit == nil {
+		return nil, fmt.Errorf("credit option is required")
+	}
+	if !slices.Contains(allowed, credit.CreditType) {
+		return nil, fmt.Errorf("invalid credit type: %s, set one of the following: %s", credit.CreditType, joinNames(allowed))
+	}
+
+	// 상하한가 조회 전에 신용 옵션을 먼저 확인한다
+	if err := c.ValidateDomesticOrder(ctx, code, qty, opt); err != nil {
+		return nil, err
+	}
+
+	body := oapi.PostUapiDomesticStockV1TradingOrderCreditJSONRequestBody{
+		"CANO":            *cano,
+		"ACNT_PRDT_CD":    fmt.Sprintf("%d", *acntprdtcd),
+		"PDNO":            code,                         // 종목코드
+		"CRDT_TYPE":       string(credit.CreditType),    // 신용유형
+		"LOAN_DT":         credit.loanDate(),            // 대출일자
+		"ORD_DVSN":        opt.getDVSN(),                // 주문구분
+		"ORD_QTY":         fmt.Sprintf("%d", qty),       // 주문수량
+		"ORD_UNPR":        fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
+		"EXCG_ID_DVSN_CD": opt.getExchange(),            // 거래소ID구분코드
+	}
+	if trID == "TTTC0851U" {
+		body["SLL_TYPE"] = opt.getSellTypeCode() // 매도유형
+	}
+
+	res, err := c.oc.PostUapiDomesticStockV1TradingOrderCredit(
+		ctx,
+		&oapi.PostUapiDomesticStockV1TradingOrderCreditParams{
+			TrId: c.trID(trID),
+		},
+		body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingOrderResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newOrderResult(res, respData, Exchange(opt.getExchange()))
+}
+
+// CreditType is the credit type code (신용유형) of the credit order.
+// 자기 is the loan of KIS and 유통 is the loan of 한국증권금융.
+type CreditType string
+
+const (
+	CreditTypeSelfLoanNew    CreditType = "21" // 자기융자신규
+	CreditTypeDistShortNew   CreditType = "22" // 유통대주신규
+	CreditTypeDistLoanNew    CreditType = "23" // 유통융자신규
+	CreditTypeSelfShortNew   CreditType = "24" // 자기대주신규
+	CreditTypeSelfLoanRepay  CreditType = "25" // 자기융자상환
+	CreditTypeDistShortRepay CreditType = "26" // 유통대주상환
+	CreditTypeDistLoanRepay  CreditType = "27" // 유통융자상환
+	CreditTypeSelfShortRepay CreditType = "28" // 자기대주상환
+)
+
+var creditTypes = []CreditType{
+	CreditTypeSelfLoanNew, CreditTypeDistShortNew, CreditTypeDistLoanNew, CreditTypeSelfShortNew,
+	CreditTypeSelfLoanRepay, CreditTypeDistShortRepay, CreditTypeDistLoanRepay, CreditTypeSelfShortRepay,
+}
+
+var creditTypeNames = map[CreditType]string{
+	CreditTypeSelfLoanNew:    "자기융자신규",
+	CreditTypeDistShortNew:   "유통대주신규",
+	CreditTypeDistLoanNew:    "유통융자신규",
+	CreditTypeSelfShortNew:   "자기대주신규",
+	CreditTypeSelfLoanRepay:  "자기융자상환",
+	CreditTypeDistShortRepay: "유통대주상환",
+	CreditTypeDistLoanRepay:  "유통융자상환",
+	CreditTypeSelfShortRepay: "자기대주상환",
+}
+
+// 신용매수는 융자신규와 대주상환, 신용매도는 융자상환과 대주신규
+var (
+	creditBuyTypes  = []CreditType{CreditTypeSelfLoanNew, CreditTypeDistLoanNew, CreditTypeSelfShortRepay, CreditTypeDistShortRepay}
+	creditSellTypes = []CreditType{CreditTypeSelfLoanRepay, CreditTypeDistLoanRepay, CreditTypeSelfShortNew, CreditTypeDistShortNew}
+)
+
+// ParseCreditType returns the CreditType of the Korean name, such as 유통융자신규.
+func ParseCreditType(name string) (CreditType, error) {
+	for _, t := range creditTypes {
+		if creditTypeNames[t] == name {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("invalid credit type: %s, set one of the following: %s", name, joinNames(creditTypes))
+}
+
+// String returns the Korean name of the credit type.
+func (t CreditType) String() string {
+	if name, ok := creditTypeNames[t]; ok {
+		return name
+	}
+	return string(t)
+}
+
+// MarshalYAML marshals the credit type in its Korean name.
+func (t CreditType) MarshalYAML() (any, error) {
+	return t.String(), nil
+}
+
+// IsRepay reports whether the credit type repays the existing loan (상환).
+func (t CreditType) IsRepay() bool {
+	switch t {
+	case CreditTypeSelfLoanRepay, CreditTypeDistShortRepay, CreditTypeDistLoanRepay, CreditTypeSelfShortRepay:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t CreditType) valid() bool {
+	_, ok := creditTypeNames[t]
+	return ok
+}
+
+// CreditOrderOptions is the options for credit order.
+// LoanDate is the loan date of the position to repay, which is Stock.LoanDate from GetDomesticHoldings.
+// It is ignored for the new (신규) credit, which is always loaned today.
+type CreditOrderOptions struct {
+	CreditType CreditType // 신용유형
+	LoanDate   time.Time  // 대출일자
+}
+
+// NewCreditOrderOptions creates a new CreditOrderOptions.
+// creditType is the Korean name of the credit type, such as 유통융자신규.
+func NewCreditOrderOptions(creditType string, loanDate time.Time) (*CreditOrderOptions, error) {
+	ct, err := ParseCreditType(creditType)
+	if err != nil {
+		return nil, err
+	}
+
+	if ct.IsRepay() && loanDate.IsZero() {
+		return nil, fmt.Errorf("loan date is required for %s", ct)
+	}
+
+	return &CreditOrderOptions{
+		CreditType: ct,
+		LoanDate:   loanDate,
+	}, nil
+}
+
+// NewCreditRepayOptions creates a CreditOrderOptions to repay the credit of the holding s.
+// Note that the position past s.ExpiredDate is forced to be sold by the broker.
+func NewCreditRepayOptions(creditType CreditType, s *Stock) (*CreditOrderOptions, error) {
+	if s == nil || s.LoanDate.IsZero() {
+		return nil, fmt.Errorf("not a credit holding")
+	}
+	if !creditType.IsRepay() {
+		return nil, fmt.Errorf("invalid repay credit type: %s", creditType)
+	}
+	return &CreditOrderOptions{
+		CreditType: creditType,
+		LoanDate:   s.LoanDate,
+	}, nil
+}
+
+func (o *CreditOrderOptions) loanDate() string {
+	if !o.CreditType.IsRepay() || o.LoanDate.IsZero() {
+		return time.Now().In(loc).Format("20060102")
+	}
+	return o.LoanDate.Format("20060102")
+}
+
+// GetCreditBuyingPower retrieves how much of the stock can be bought on credit (신용매수가능조회).
+// creditType should be a loan-new (융자신규) or short-repay (대주상환) type.
+// price is ignored and sent as 0 for the market-priced order types, such as OrderTypeMarket (see OrderType.IsMarket).
+// It is not supported in VTS.
+func (c *Client) GetCreditBuyingPower(ctx context.Context, code string, price int, orderType OrderType, creditType CreditType) (*BuyingPower, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("get credit buying power is not supported in %s", c.env)
+	}
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+	if price < 0 {
+		return nil, fmt.Errorf("invalid order price: %d", price)
+	}
+	if !orderType.valid() {
+		return nil, fmt.Errorf("invalid order type: %s", orderType)
+	}
+	if !slices.Contains(creditBuyTypes, creditType) {
+		return nil, fmt.Errorf("invalid credit type: %s, set one of the following: %s", creditType, joinNames(creditBuyTypes))
+	}
+	if orderType.IsMarket() {
+		price = 0
+	}
+
+	cano, acntprdtcd, err := c.accountParams(creditProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	resp, err := c.oc.GetUapiDomesticStockV1TradingInquireCreditPsamount(
+		ctx,
+		&oapi.GetUapiDomesticStockV1TradingInquireCreditPsamountParams{
+			CANO:             cano,
+			ACNTPRDTCD:       acntprdtcd,
+			ORDUNPR:          ptr(price),
+			OVRSICLDYN:       ptr(toStr(false)), // 해외포함여부
+			CMAEVLUAMTICLDYN: ptr(toStr(false)), // CMA평가금액포함여부
+			TrId:             c.trID("TTTC8909R"),
+		},
+		withQuery("PDNO", code),
+		withQuery("ORD_DVSN", string(orderType)),
+		withQuery("CRDT_TYPE", string(creditType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 응답은 매수가능조회와 같은 형식이다
+	respData := &uapiDomesticStockV1TradingInquirePsblOrderResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return validateBuyingPower(resp, respData)
+}
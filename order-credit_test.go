@@ -0,0 +1,109 @@
+package kinvest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderCredit(t *testing.T) {
+	var body map[string]any
+	var trID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-price":
+			t.Error("price limits should not be queried for the invalid credit option")
+		case "/uapi/domestic-stock/v1/trading/order-credit":
+			trID = r.Header.Get("tr_id")
+			body = nil
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"APBK0013","msg1":"주문 전송 완료 되었습니다.","output":{"KRX_FWDG_ORD_ORGNO":"91252","ODNO":"0000117057","ORD_TMD":"121052"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	credit, err := NewCreditOrderOptions("유통융자신규", time.Time{})
+	assert.NoError(t, err)
+	res, err := c.BuyDomesticStockOnCredit(ctx, "005930", 3, credit, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0000117057", res.OrderNo)
+		assert.Equal(t, "TTTC0852U", trID)
+		assert.Equal(t, "23", body["CRDT_TYPE"])
+		assert.Equal(t, time.Now().In(loc).Format("20060102"), body["LOAN_DT"])
+		assert.NotContains(t, body, "SLL_TYPE")
+		assert.Equal(t, "KRX", body["EXCG_ID_DVSN_CD"])
+		assert.Equal(t, ExchangeKRX, res.Exchange)
+	}
+
+	// 매수 주문에 상환 매도 유형은 쓸 수 없다
+	limit := &OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 72000}
+	_, err = c.BuyDomesticStockOnCredit(ctx, "005930", 3, &CreditOrderOptions{CreditType: CreditTypeDistLoanRepay}, limit)
+	assert.Error(t, err)
+	_, err = c.BuyDomesticStockOnCredit(ctx, "005930", 3, nil, limit)
+	assert.Error(t, err)
+
+	holding := &Stock{Code: "005930", LoanDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)}
+	credit, err = NewCreditRepayOptions(CreditTypeDistLoanRepay, holding)
+	assert.NoError(t, err)
+	res, err = c.SellDomesticStockOnCredit(ctx, "005930", 3, credit, &OrderDomesticStockOptions{Type: OrderTypeMarket, Exchange: ExchangeSOR})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ExchangeSOR, res.Exchange)
+		assert.Equal(t, "SOR", body["EXCG_ID_DVSN_CD"])
+		assert.Equal(t, "TTTC0851U", trID)
+		assert.Equal(t, "27", body["CRDT_TYPE"])
+		assert.Equal(t, "20240304", body["LOAN_DT"])
+		assert.Equal(t, "01", body["SLL_TYPE"])
+	}
+
+	_, err = NewCreditRepayOptions(CreditTypeDistLoanRepay, &Stock{Code: "005930"})
+	assert.Error(t, err)
+	_, err = NewCreditOrderOptions("자기융자상환", time.Time{})
+	assert.Error(t, err)
+}
+
+func TestGetCreditBuyingPower(t *testing.T) {
+	var prices []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-credit-psamount":
+			q := r.URL.Query()
+			assert.Equal(t, "TTTC8909R", r.Header.Get("tr_id"))
+			assert.Equal(t, "005930", q.Get("PDNO"))
+			assert.Equal(t, "21", q.Get("CRDT_TYPE"))
+			prices = append(prices, q.Get("ORD_DVSN")+":"+q.Get("ORD_UNPR"))
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0510","msg1":"조회가 완료되었습니다","output":{"ord_psbl_cash":"1000000","max_buy_amt":"2500000","max_buy_qty":"35"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	bp, err := c.GetCreditBuyingPower(ctx, "005930", 70000, OrderTypeLimit, CreditTypeSelfLoanNew)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(35), bp.MaxBuyQty)
+	}
+	// 시장가는 가격을 0 으로 보낸다
+	_, err = c.GetCreditBuyingPower(ctx, "005930", 70000, OrderTypeMarket, CreditTypeSelfLoanNew)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"00:70000", "01:0"}, prices)
+
+	_, err = c.GetCreditBuyingPower(ctx, "005930", 70000, OrderTypeLimit, CreditTypeSelfLoanRepay)
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+// 국내주식 > 주문/계좌 > 매수가능조회, 매도가능수량조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// GetBuyingPower retrieves how much of the stock can be bought at price with orderType (매수가능조회).
+// price is ignored and sent as 0 for the market-priced order types, such as OrderTypeMarket (see OrderType.IsMarket).
+func (c *Client) GetBuyingPower(ctx context.Context, code string, price int, orderType OrderType) (*BuyingPower, error) {
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+	if price < 0 {
+		return nil, fmt.Errorf("invalid order price: %d", price)
+	}
+	if !orderType.valid() {
+		return nil, fmt.Errorf("invalid order type: %s", orderType)
+	}
+
+	if orderType.IsMarket() {
+		price = 0
+	}
+
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	resp, err := c.oc.GetUapiDomesticStockV1TradingInquirePsblOrder(
+		ctx,
+		&oapi.GetUapiDomesticStockV1TradingInquirePsblOrderParams{
+			CANO:             cano,
+			ACNTPRDTCD:       acntprdtcd,
+			ORDUNPR:          ptr(price),
+			OVRSICLDYN:       ptr(toStr(false)), // 해외포함여부
+			CMAEVLUAMTICLDYN: ptr(toStr(false)), // CMA평가금액포함여부
+			TrId:             c.trID("TTTC8908R"),
+		},
+		withQuery("PDNO", code),
+		withQuery("ORD_DVSN", string(orderType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingInquirePsblOrderResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return validateBuyingPower(resp, respData)
+}
+
+// BuyingPower represents 매수가능조회.
+// The MaxBuy figures include the margin (미수) while the NoMarginBuy figures do not.
+type BuyingPower struct {
+	OrderableCash  int64 `yaml:"주문가능현금"`
+	OrderableSbst  int64 `yaml:"주문가능대용"`
+	ReusableAmount int64 `yaml:"재사용가능금액"`
+	CalcPrice      int64 `yaml:"가능수량계산단가"` // 수량 계산에 쓰인 단가, 시장가는 상한가
+	NoMarginBuyAmt int64 `yaml:"미수없는매수금액"`
+	NoMarginBuyQty int64 `yaml:"미수없는매수수량"`
+	MaxBuyAmt      int64 `yaml:"최대매수금액"`
+	MaxBuyQty      int64 `yaml:"최대매수수량"`
+}
+
+type uapiDomesticStockV1TradingInquirePsblOrderResponse struct {
+	Output *psblOrderOutput `json:"output"`
+	RtCd   string           `json:"rt_cd"`
+	MsgCd  string           `json:"msg_cd"`
+	Msg1   string           `json:"msg1"`
+}
+
+type psblOrderOutput struct {
+	OrdPsblCash     string `json:"ord_psbl_cash"`      // 주문가능현금
+	OrdPsblSbst     string `json:"ord_psbl_sbst"`      // 주문가능대용
+	RusePsblAmt     string `json:"ruse_psbl_amt"`      // 재사용가능금액
+	PsblQtyCalcUnpr string `json:"psbl_qty_calc_unpr"` // 가능수량계산단가
+	NrcvbBuyAmt     string `json:"nrcvb_buy_amt"`      // 미수없는매수금액
+	NrcvbBuyQty     string `json:"nrcvb_buy_qty"`      // 미수없는매수수량
+	MaxBuyAmt       string `json:"max_buy_amt"`        // 최대매수금액
+	MaxBuyQty       string `json:"max_buy_qty"`        // 최대매수수량
+}
+
+func validateBuyingPower(resp *http.Response, data *uapiDomesticStockV1TradingInquirePsblOrderResponse) (*BuyingPower, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+	if data.Output == nil {
+		return nil, fmt.Errorf("no output in response")
+	}
+
+	o := data.Output
+	return &BuyingPower{
+		OrderableCash:  toInt64(o.OrdPsblCash),
+		OrderableSbst:  toInt64(o.OrdPsblSbst),
+		ReusableAmount: toInt64(o.RusePsblAmt),
+		CalcPrice:      toInt64(o.PsblQtyCalcUnpr),
+		NoMarginBuyAmt: toInt64(o.NrcvbBuyAmt),
+		NoMarginBuyQty: toInt64(o.NrcvbBuyQty),
+		MaxBuyAmt:      toInt64(o.MaxBuyAmt),
+		MaxBuyQty:      toInt64(o.MaxBuyQty),
+	}, nil
+}
+
+// GetSellableQty retrieves the sellable quantity of the stock in the account (매도가능수량조회).
+// It is not supported in VTS.
+func (c *Client) GetSellableQty(ctx context.Context, code string) (*SellableQty, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("get sellable qty is not supported in %s", c.env)
+	}
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	resp, err := c.oc.GetUapiDomesticStockV1TradingInquirePsblSell(
+		ctx,
+		&oapi.GetUapiDomesticStockV1TradingInquirePsblSellParams{
+			CANO:       cano,
+			ACNTPRDTCD: acntprdtcd,
+			TrId:       c.trID("TTTC8408R"),
+		},
+		withQuery("PDNO", code),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingInquirePsblSellResponse{}
+	if err := unmarshalJsonBody(resp.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return validateSellableQty(resp, respData)
+}
+
+// SellableQty represents 매도가능수량조회.
+type SellableQty struct {
+	Code         string  `yaml:"종목코드"`
+	Name         string  `yaml:"종목명"`
+	HoldingQty   int64   `yaml:"잔고수량"`
+	SellableQty  int64   `yaml:"주문가능수량"`
+	AvgPrice     float64 `yaml:"매입평균가격"`
+	CurrentPrice int64   `yaml:"현재가"`
+	EvalAmt      int64   `yaml:"평가금액"`
+	EvalPnl      int64   `yaml:"평가손익금액"`
+	EvalPnlRate  float64 `yaml:"평가손익율"`
+}
+
+type uapiDomesticStockV1TradingInquirePsblSellResponse struct {
+	Output *psblSellOutput `json:"output1"`
+	RtCd   string          `json:"rt_cd"`
+	MsgCd  string          `json:"msg_cd"`
+	Msg1   string          `json:"msg1"`
+}
+
+type psblSellOutput struct {
+	Pdno        string `json:"pdno"`          // 상품번호
+	PrdtName    string `json:"prdt_name"`     // 상품명
+	CblcQty     string `json:"cblc_qty"`      // 잔고수량
+	OrdPsblQty  string `json:"ord_psbl_qty"`  // 주문가능수량
+	PchsAvgPric string `json:"pchs_avg_pric"` // 매입평균가격
+	NowPric     string `json:"now_pric"`      // 현재가
+	EvluAmt     string `json:"evlu_amt"`      // 평가금액
+	EvluPflsAmt string `json:"evlu_pfls_amt"` // 평가손익금액
+	EvluPflsRt  string `json:"evlu_pfls_rt"`  // 평가손익율
+}
+
+func validateSellableQty(resp *http.Response, data *uapiDomesticStockV1TradingInquirePsblSellResponse) (*SellableQty, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+	if data.Output == nil {
+		return nil, fmt.Errorf("no output in response")
+	}
+
+	o := data.Output
+	return &SellableQty{
+		Code:         o.Pdno,
+		Name:         o.PrdtName,
+		HoldingQty:   toInt64(o.CblcQty),
+		SellableQty:  toInt64(o.OrdPsblQty),
+		AvgPrice:     toFloat(o.PchsAvgPric),
+		CurrentPrice: toInt64(o.NowPric),
+		EvalAmt:      toInt64(o.EvluAmt),
+		EvalPnl:      toInt64(o.EvluPflsAmt),
+		EvalPnlRate:  toFloat(o.EvluPflsRt),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package kinvest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBuyingPowerAndSellableQty(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-psbl-order":
+			assert.Equal(t, "005930", q.Get("PDNO"))
+			// 시장가는 가격을 0 으로 보낸다
+			if q.Get("ORD_DVSN") == "01" {
+				assert.Equal(t, "0", q.Get("ORD_UNPR"))
+			} else {
+				assert.Equal(t, "00", q.Get("ORD_DVSN"))
+				assert.Equal(t, "70000", q.Get("ORD_UNPR"))
+			}
+			assert.Equal(t, "01", q.Get("ACNT_PRDT_CD"))
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0510","msg1":"조회가 완료되었습니다","output":{"ord_psbl_cash":"1000000","ord_psbl_sbst":"0","ruse_psbl_amt":"0","psbl_qty_calc_unpr":"70000","nrcvb_buy_amt":"980000","nrcvb_buy_qty":"14","max_buy_amt":"3200000","max_buy_qty":"45"}}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-psbl-sell":
+			assert.Equal(t, "005930", q.Get("PDNO"))
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0510","msg1":"조회가 완료되었습니다","output1":{"pdno":"005930","prdt_name":"삼성전자","cblc_qty":"10","ord_psbl_qty":"7","pchs_avg_pric":"65000.5000","now_pric":"70000","evlu_amt":"700000","evlu_pfls_amt":"49995","evlu_pfls_rt":"7.69"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	bp, err := c.GetBuyingPower(ctx, "005930", 70000, OrderTypeLimit)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1000000), bp.OrderableCash)
+		assert.Equal(t, int64(14), bp.NoMarginBuyQty)
+		assert.Equal(t, int64(45), bp.MaxBuyQty)
+	}
+
+	_, err = c.GetBuyingPower(ctx, "005930", 70000, OrderTypeMarket)
+	assert.NoError(t, err)
+
+	_, err = c.GetBuyingPower(ctx, "005930", 70000, OrderType("99"))
+	assert.Error(t, err)
+
+	sq, err := c.GetSellableQty(ctx, "005930")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "삼성전자", sq.Name)
+		assert.Equal(t, int64(10), sq.HoldingQty)
+		assert.Equal(t, int64(7), sq.SellableQty)
+		assert.Equal(t, 65000.5, sq.AvgPrice)
+	}
+}
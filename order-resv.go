@@ -0,0 +1,403 @@
+// 국내주식 > 주문/계좌 > 주식예약주문, 주식예약주문정정취소, 주식예약주문조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// PlaceReservedOrder places a reserved order (예약주문) which is sent to the exchange in the next session.
+// KIS accepts the reserved orders from 15:40 to 07:30 of the next business day.
+// It is not supported in VTS.
+func (c *Client) PlaceReservedOrder(ctx context.Context, code string, qty int, opt *ReservedOrderOptions) (*ReservedOrderResult, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("reserved order is not supported in %s", c.env)
+	}
+
+	body, err := c.reservedOrderBody(code, qty, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.oc.PostUapiDomesticStockV1TradingOrderResv(
+		ctx,
+		&oapi.PostUapiDomesticStockV1TradingOrderResvParams{
+			TrId: c.trID("CTSC0008U"),
+		},
+		body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingOrderResvResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if respData.RtCd != "0" {
+		return nil, newAPIError(res, respData.RtCd, respData.MsgCd, respData.Msg1)
+	}
+	if respData.Output == nil || respData.Output.RsvnOrdSeq == "" {
+		return nil, fmt.Errorf("response output is nil")
+	}
+
+	return &ReservedOrderResult{
+		ReservationNo: respData.Output.RsvnOrdSeq,
+	}, nil
+}
+
+// ModifyReservedOrder modifies the reserved order of reservationNo.
+// code, qty and opt replace the ones of the original reserved order.
+// It is not supported in VTS.
+func (c *Client) ModifyReservedOrder(ctx context.Context, reservationNo, code string, qty int, opt *ReservedOrderOptions) error {
+	if c.env == EnvironmentVTS {
+		return fmt.Errorf("reserved order is not supported in %s", c.env)
+	}
+	if reservationNo == "" {
+		return fmt.Errorf("invalid reservation no: %s", reservationNo)
+	}
+
+	body, err := c.reservedOrderBody(code, qty, opt)
+	if err != nil {
+		return err
+	}
+	body["RSVN_ORD_SEQ"] = reservationNo // 예약주문순번
+
+	return c.reviseCancelReservedOrder(ctx, "CTSC0013U", body)
+}
+
+// CancelReservedOrder cancels the reserved order of reservationNo.
+// It is not supported in VTS.
+func (c *Client) CancelReservedOrder(ctx context.Context, reservationNo string) error {
+	if c.env == EnvironmentVTS {
+		return fmt.Errorf("reserved order is not supported in %s", c.env)
+	}
+	if reservationNo == "" {
+		return fmt.Errorf("invalid reservation no: %s", reservationNo)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(creditProducts)
+	if err != nil {
+		return fmt.Errorf("parse account failed: %w", err)
+	}
+
+	return c.reviseCancelReservedOrder(ctx, "CTSC0009U", oapi.PostUapiDomesticStockV1TradingOrderResvRvsecnclJSONRequestBody{
+		"CANO":         *cano,
+		"ACNT_PRDT_CD": fmt.Sprintf("%d", *acntprdtcd),
+		"RSVN_ORD_SEQ": reservationNo, // 예약주문순번
+	})
+}
+
+func (c *Client) reviseCancelReservedOrder(ctx context.Context, trID string, body map[string]any) error {
+	res, err := c.oc.PostUapiDomesticStockV1TradingOrderResvRvsecncl(
+		ctx,
+		&oapi.PostUapiDomesticStockV1TradingOrderResvRvsecnclParams{
+			TrId: c.trID(trID),
+		},
+		body,
+	)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingOrderResvRvsecnclResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if respData.RtCd != "0" {
+		return newAPIError(res, respData.RtCd, respData.MsgCd, respData.Msg1)
+	}
+	if respData.Output != nil && respData.Output.NrmlPrcsYn == "N" {
+		return fmt.Errorf("reserved order not processed: %s", respData.Msg1)
+	}
+
+	return nil
+}
+
+func (c *Client) reservedOrderBody(code string, qty int, opt *ReservedOrderOptions) (map[string]any, error) {
+	if len(code) != 6 {
+		return nil, fmt.Errorf("invalid item no: %s", code)
+	}
+
+	if qty <= 0 {
+		return nil, fmt.Errorf("invalid qty: %d", qty)
+	}
+
+	if opt == nil {
+		return nil, fmt.Errorf("reserved order option is nil")
+	}
+	if opt.Side != "매수" && opt.Side != "매도" {
+		return nil, fmt.Errorf("invalid side: %s, set one of the following: 매수, 매도", opt.Side)
+	}
+	if !slices.Contains(reservedOrderTypes, opt.Type) {
+		return nil, fmt.Errorf("invalid order type: %s, set one of the following: %s", opt.Type, joinNames(reservedOrderTypes))
+	}
+	if opt.Price < 0 {
+		return nil, fmt.Errorf("invalid order price: %d", opt.Price)
+	}
+	if opt.Type.IsMarket() && opt.Price != 0 {
+		return nil, fmt.Errorf("%s order with price %d", opt.Type, opt.Price)
+	}
+	if opt.Type.isLimit() && opt.Price == 0 {
+		return nil, fmt.Errorf("%s order without price", opt.Type)
+	}
+	if opt.Credit != nil {
+		// 신용매수는 융자신규와 대주상환, 신용매도는 융자상환과 대주신규
+		allowed := creditBuyTypes
+		if opt.Side == "매도" {
+			allowed = creditSellTypes
+		}
+		if !slices.Contains(allowed, opt.Credit.CreditType) {
+			return nil, fmt.Errorf("invalid credit type: %s, set one of the following: %s", opt.Credit.CreditType, joinNames(allowed))
+		}
+	}
+
+	cano, acntprdtcd, err := c.accountParams(creditProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	body := map[string]any{
+		"CANO":                  *cano,
+		"ACNT_PRDT_CD":          fmt.Sprintf("%d", *acntprdtcd),
+		"PDNO":                  code,                         // 종목코드
+		"ORD_QTY":               fmt.Sprintf("%d", qty),       // 주문수량
+		"ORD_UNPR":              fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
+		"SLL_BUY_DVSN_CD":       opt.sideCode(),               // 01: 매도, 02: 매수
+		"ORD_DVSN_CD":           string(opt.Type),             // 주문구분
+		"ORD_OBJT_CBLC_DVSN_CD": "10",                         // 주문대상잔고구분 10: 현금
+	}
+	if opt.Credit != nil {
+		body["ORD_OBJT_CBLC_DVSN_CD"] = string(opt.Credit.CreditType)
+		body["LOAN_DT"] = opt.Credit.loanDate()
+	}
+	if !opt.EndDate.IsZero() {
+		body["RSVN_ORD_END_DT"] = opt.EndDate.In(loc).Format("20060102") // 기간예약 종료일자
+	}
+
+	return body, nil
+}
+
+// ReservedOrderOptions is the options for reserved order.
+// Side is 매수 or 매도, and Type is one of OrderTypeLimit, OrderTypeMarket,
+// OrderTypeConditionalLimit and OrderTypePreMarketOvertime.
+// If EndDate is set, the order is placed every business day until EndDate (기간예약주문).
+// Credit is set to order on credit, or nil for cash.
+type ReservedOrderOptions struct {
+	Side    string              // 매도매수구분
+	Type    OrderType           // 주문구분
+	Price   int                 // 주문단가
+	EndDate time.Time           // 예약주문종료일자
+	Credit  *CreditOrderOptions // 신용주문
+}
+
+// NewReservedOrderOptions creates a new ReservedOrderOptions.
+// orderType is the Korean name of the order type, such as 지정가.
+func NewReservedOrderOptions(side, orderType string, orderPrice int) (*ReservedOrderOptions, error) {
+	if side != "매수" && side != "매도" {
+		return nil, fmt.Errorf("invalid side: %s, set one of the following: 매수, 매도", side)
+	}
+
+	ot, err := ParseOrderType(orderType)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(reservedOrderTypes, ot) {
+		return nil, fmt.Errorf("invalid order type: %s, set one of the following: %s", orderType, joinNames(reservedOrderTypes))
+	}
+
+	if orderPrice < 0 {
+		return nil, fmt.Errorf("invalid order price: %d", orderPrice)
+	}
+
+	return &ReservedOrderOptions{
+		Side:  side,
+		Type:  ot,
+		Price: orderPrice,
+	}, nil
+}
+
+func (o *ReservedOrderOptions) sideCode() string {
+	if o.Side == "매도" {
+		return "01"
+	}
+	return "02"
+}
+
+var reservedOrderTypes = []OrderType{OrderTypeLimit, OrderTypeMarket, OrderTypeConditionalLimit, OrderTypePreMarketOvertime}
+
+type uapiDomesticStockV1TradingOrderResvResponse struct {
+	Output *struct {
+		RsvnOrdSeq string `json:"RSVN_ORD_SEQ"` // 예약주문순번
+	} `json:"output"`
+	RtCd  string `json:"rt_cd"`
+	MsgCd string `json:"msg_cd"`
+	Msg1  string `json:"msg1"`
+}
+
+type uapiDomesticStockV1TradingOrderResvRvsecnclResponse struct {
+	Output *struct {
+		NrmlPrcsYn string `json:"NRML_PRCS_YN"` // 정상처리여부
+	} `json:"output"`
+	RtCd  string `json:"rt_cd"`
+	MsgCd string `json:"msg_cd"`
+	Msg1  string `json:"msg1"`
+}
+
+// ReservedOrderResult is the result of the reserved order.
+type ReservedOrderResult struct {
+	ReservationNo string `yaml:"예약주문순번"`
+}
+
+// ListReservedOrders retrieves the reserved orders placed between from and to.
+// It follows the continuation keys and returns orders of all pages.
+// It is not supported in VTS.
+func (c *Client) ListReservedOrders(ctx context.Context, from, to time.Time) ([]*ReservedOrder, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("list reserved orders is not supported in %s", c.env)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid period: %s ~ %s", from.Format("20060102"), to.Format("20060102"))
+	}
+
+	cano, acntprdtcd, err := c.accountParams(creditProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	var ret []*ReservedOrder
+	var ctxAreaFK, ctxAreaNK string
+	for {
+		resp, err := c.oc.GetUapiDomesticStockV1TradingOrderResvCcnl(
+			ctx,
+			&oapi.GetUapiDomesticStockV1TradingOrderResvCcnlParams{
+				RSVNORDSEQ:   ptr(""),
+				CANO:         cano,
+				ACNTPRDTCD:   acntprdtcd,
+				PRCSDVSNCD:   ptr(0),
+				CNCLYN:       ptr(toStr(true)), // 유효한 주문만 조회
+				PDNO:         ptr(""),          // 전체 종목
+				SLLBUYDVSNCD: ptr(""),
+				CTXAREAFK200: ptr(ctxAreaFK),
+				CTXAREANK200: ptr(ctxAreaNK),
+				TrId:         c.trID("CTSC0004R"),
+			},
+			withQuery("RSVN_ORD_ORD_DT", from.In(loc).Format("20060102")),
+			withQuery("RSVN_ORD_END_DT", to.In(loc).Format("20060102")),
+			withQuery("TMNL_MDIA_KIND_CD", "00"),
+			withTrCont(ctxAreaNK != ""),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		respData := &uapiDomesticStockV1TradingOrderResvCcnlResponse{}
+		err = unmarshalJsonBody(resp.Body, respData)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal response failed: %w", err)
+		}
+
+		orders, err := validateReservedOrders(resp, respData)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, orders...)
+
+		if !hasNextPage(resp) || len(orders) == 0 ||
+			(respData.CtxAreaFk200 == ctxAreaFK && respData.CtxAreaNk200 == ctxAreaNK) {
+			break
+		}
+		ctxAreaFK, ctxAreaNK = respData.CtxAreaFk200, respData.CtxAreaNk200
+	}
+
+	return ret, nil
+}
+
+type uapiDomesticStockV1TradingOrderResvCcnlResponse struct {
+	Output       []*orderResvCcnlOutput `json:"output"`
+	CtxAreaFk200 string                 `json:"ctx_area_fk200"`
+	CtxAreaNk200 string                 `json:"ctx_area_nk200"`
+	RtCd         string                 `json:"rt_cd"`
+	MsgCd        string                 `json:"msg_cd"`
+	Msg1         string                 `json:"msg1"`
+}
+
+type orderResvCcnlOutput struct {
+	RsvnOrdSeq      string `json:"rsvn_ord_seq"`       // 예약주문순번
+	RsvnOrdOrdDt    string `json:"rsvn_ord_ord_dt"`    // 예약주문주문일자
+	Pdno            string `json:"pdno"`               // 상품번호
+	KorItemShtnName string `json:"kor_item_shtn_name"` // 한글종목단축명
+	SllBuyDvsnCd    string `json:"sll_buy_dvsn_cd"`    // 매도매수구분코드
+	OrdDvsnName     string `json:"ord_dvsn_name"`      // 주문구분명
+	OrdRsvnQty      string `json:"ord_rsvn_qty"`       // 주문예약수량
+	OrdRsvnUnpr     string `json:"ord_rsvn_unpr"`      // 주문예약단가
+	TotCcldQty      string `json:"tot_ccld_qty"`       // 총체결수량
+	TotCcldAmt      string `json:"tot_ccld_amt"`       // 총체결금액
+	Odno            string `json:"odno"`               // 주문번호
+	RsvnEndDt       string `json:"rsvn_end_dt"`        // 예약종료일자
+	CnclOrdDt       string `json:"cncl_ord_dt"`        // 취소주문일자
+	PrcsRslt        string `json:"prcs_rslt"`          // 처리결과
+	RjctRson2       string `json:"rjct_rson2"`         // 거부사유2
+}
+
+// ReservedOrder represents a reserved order.
+type ReservedOrder struct {
+	ReservationNo string    `yaml:"예약주문순번"`
+	OrderDate     time.Time `yaml:"예약주문일자"`
+	Code          string    `yaml:"종목번호"`
+	Name          string    `yaml:"종목명"`
+	Side          string    `yaml:"매도매수구분"` // 매도, 매수
+	OrderType     string    `yaml:"주문구분"`
+	OrderQty      int       `yaml:"주문예약수량"`
+	OrderPrice    int       `yaml:"주문예약단가"`
+	FilledQty     int       `yaml:"총체결수량,omitempty"`
+	FilledAmount  int       `yaml:"총체결금액,omitempty"`
+	OrderNo       string    `yaml:"주문번호,omitempty"` // 거래소에 전송된 뒤의 주문번호
+	EndDate       time.Time `yaml:"예약종료일자,omitempty"`
+	CanceledDate  time.Time `yaml:"취소주문일자,omitempty"`
+	Result        string    `yaml:"처리결과,omitempty"`
+	RejectReason  string    `yaml:"거부사유,omitempty"`
+}
+
+func validateReservedOrders(resp *http.Response, data *uapiDomesticStockV1TradingOrderResvCcnlResponse) ([]*ReservedOrder, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	var ret []*ReservedOrder
+	for _, o := range data.Output {
+		if o == nil || o.RsvnOrdSeq == "" {
+			continue
+		}
+		ret = append(ret, &ReservedOrder{
+			ReservationNo: o.RsvnOrdSeq,
+			OrderDate:     toTime(o.RsvnOrdOrdDt),
+			Code:          o.Pdno,
+			Name:          o.KorItemShtnName,
+			Side:          conv.SideNames[o.SllBuyDvsnCd],
+			OrderType:     o.OrdDvsnName,
+			OrderQty:      toInt(o.OrdRsvnQty),
+			OrderPrice:    toInt(o.OrdRsvnUnpr),
+			FilledQty:     toInt(o.TotCcldQty),
+			FilledAmount:  toInt(o.TotCcldAmt),
+			OrderNo:       o.Odno,
+			EndDate:       toTime(o.RsvnEndDt),
+			CanceledDate:  toTime(o.CnclOrdDt),
+			Result:        o.PrcsRslt,
+			RejectReason:  o.RjctRson2,
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,107 @@
+package kinvest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservedOrders(t *testing.T) {
+	var body map[string]any
+	var trID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trID = r.Header.Get("tr_id")
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/trading/order-resv":
+			body = nil
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0000","msg1":"정상적으로 처리되었습니다.","output":{"RSVN_ORD_SEQ":"88793"}}`))
+		case "/uapi/domestic-stock/v1/trading/order-resv-rvsecncl":
+			body = nil
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0000","msg1":"정상적으로 처리되었습니다.","output":{"NRML_PRCS_YN":"Y"}}`))
+		case "/uapi/domestic-stock/v1/trading/order-resv-ccnl":
+			q := r.URL.Query()
+			assert.Equal(t, "20240301", q.Get("RSVN_ORD_ORD_DT"))
+			assert.Equal(t, "00", q.Get("TMNL_MDIA_KIND_CD"))
+			if q.Get("CTX_AREA_NK200") == "" {
+				w.Header().Set("tr_cont", "M")
+				w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0000","msg1":"조회되었습니다","ctx_area_fk200":"fk","ctx_area_nk200":"nk","output":[{"rsvn_ord_seq":"88793","rsvn_ord_ord_dt":"20240304","pdno":"005930","kor_item_shtn_name":"삼성전자","sll_buy_dvsn_cd":"02","ord_dvsn_name":"지정가","ord_rsvn_qty":"10","ord_rsvn_unpr":"70000"}]}`))
+				return
+			}
+			w.Header().Set("tr_cont", "D")
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"KIOK0000","msg1":"조회되었습니다","ctx_area_fk200":"fk","ctx_area_nk200":"","output":[{"rsvn_ord_seq":"88794","rsvn_ord_ord_dt":"20240304","pdno":"000660","kor_item_shtn_name":"SK하이닉스","sll_buy_dvsn_cd":"01","ord_dvsn_name":"시장가","ord_rsvn_qty":"3","ord_rsvn_unpr":"0","odno":"0000123"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	opt, err := NewReservedOrderOptions("매수", "지정가", 70000)
+	if !assert.NoError(t, err) {
+		return
+	}
+	res, err := c.PlaceReservedOrder(ctx, "005930", 10, opt)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "88793", res.ReservationNo)
+		assert.Equal(t, "CTSC0008U", trID)
+		assert.Equal(t, "02", body["SLL_BUY_DVSN_CD"])
+		assert.Equal(t, "00", body["ORD_DVSN_CD"])
+		assert.Equal(t, "10", body["ORD_OBJT_CBLC_DVSN_CD"])
+	}
+
+	opt.Price = 69000
+	if assert.NoError(t, c.ModifyReservedOrder(ctx, "88793", "005930", 10, opt)) {
+		assert.Equal(t, "CTSC0013U", trID)
+		assert.Equal(t, "88793", body["RSVN_ORD_SEQ"])
+		assert.Equal(t, "69000", body["ORD_UNPR"])
+	}
+
+	if assert.NoError(t, c.CancelReservedOrder(ctx, "88793")) {
+		assert.Equal(t, "CTSC0009U", trID)
+		assert.Equal(t, "88793", body["RSVN_ORD_SEQ"])
+	}
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, loc)
+	orders, err := c.ListReservedOrders(ctx, from, from.AddDate(0, 0, 7))
+	if assert.NoError(t, err) && assert.Len(t, orders, 2) {
+		assert.Equal(t, "매수", orders[0].Side)
+		assert.Equal(t, 70000, orders[0].OrderPrice)
+		assert.Equal(t, "매도", orders[1].Side)
+		assert.Equal(t, "0000123", orders[1].OrderNo)
+	}
+
+	_, err = NewReservedOrderOptions("매수", "IOC지정가", 70000)
+	assert.Error(t, err)
+
+	// 잘못된 옵션은 요청하지 않고 거부한다
+	body = nil
+	for _, opt := range []*ReservedOrderOptions{
+		{Type: OrderTypeLimit, Price: 70000},               // 매도매수구분 없음
+		{Side: "sell", Type: OrderTypeLimit, Price: 70000}, // 잘못된 매도매수구분
+		{Side: "매수", Type: OrderTypeMarket, Price: 70000},  // 시장가에 가격
+		{Side: "매수", Type: OrderTypeLimit},                 // 지정가에 가격 없음
+		{Side: "매수", Type: OrderTypeLimit, Price: 70000, Credit: &CreditOrderOptions{CreditType: CreditTypeDistShortNew}}, // 매수에 대주신규
+		{Side: "매도", Type: OrderTypeLimit, Price: 70000, Credit: &CreditOrderOptions{CreditType: CreditTypeSelfLoanNew}},  // 매도에 융자신규
+	} {
+		_, err = c.PlaceReservedOrder(ctx, "005930", 10, opt)
+		assert.Error(t, err, "%+v", opt)
+	}
+	assert.Nil(t, body)
+
+	_, err = c.PlaceReservedOrder(ctx, "005930", 10, &ReservedOrderOptions{Side: "매도", Type: OrderTypeMarket, Credit: &CreditOrderOptions{CreditType: CreditTypeSelfLoanRepay}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "01", body["SLL_BUY_DVSN_CD"])
+		assert.Equal(t, "25", body["ORD_OBJT_CBLC_DVSN_CD"])
+	}
+}
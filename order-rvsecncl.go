@@ -0,0 +1,239 @@
+// 국내주식 > 주문/계좌 > 주식주문(정정취소), 주식정정취소가능주문조회
+
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/conv"
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// ModifyDomesticOrder modifies the type and price of an open domestic stock order of the stock code.
+// ex, venue and orderNo are OrderResult.Exchange, OrderResult.Venue and OrderResult.OrderNo of the original order.
+// The order is modified on ex, so opt.Exchange should be empty or the same as ex.
+// If qty is 0, all remaining quantity of the order is modified.
+// The new price is checked against the tick grid and the price limits as ValidateDomesticOrder does.
+func (c *Client) ModifyDomesticOrder(ctx context.Context, code string, ex Exchange, venue, orderNo string, qty int, opt *OrderDomesticStockOptions) (*OrderResult, error) {
+	if !ex.valid() {
+		return nil, fmt.Errorf("invalid exchange: %s, set one of the following: %s", ex, joinNames(exchanges))
+	}
+	if opt == nil {
+		return nil, fmt.Errorf("%w: order option is nil", ErrInvalidOrder)
+	}
+	if opt.Exchange != "" && opt.Exchange != ex {
+		return nil, fmt.Errorf("%w: the order on %s can not be modified to %s", ErrInvalidOrder, ex, opt.Exchange)
+	}
+
+	mopt := *opt
+	mopt.Exchange = ex
+	if err := c.validateDomesticOrderPrice(ctx, code, &mopt); err != nil {
+		return nil, err
+	}
+
+	return c.reviseCancelDomesticOrder(ctx, venue, orderNo, "01", qty, &mopt)
+}
+
+// CancelDomesticOrder cancels an open domestic stock order.
+// ex, venue and orderNo are OrderResult.Exchange, OrderResult.Venue and OrderResult.OrderNo of the original order.
+// If qty is 0, all remaining quantity of the order is canceled.
+func (c *Client) CancelDomesticOrder(ctx context.Context, ex Exchange, venue, orderNo string, qty int) (*OrderResult, error) {
+	if !ex.valid() {
+		return nil, fmt.Errorf("invalid exchange: %s, set one of the following: %s", ex, joinNames(exchanges))
+	}
+
+	opt := &OrderDomesticStockOptions{
+		Type:     OrderTypeLimit,
+		Price:    0,
+		Exchange: ex,
+	}
+
+	return c.reviseCancelDomesticOrder(ctx, venue, orderNo, "02", qty, opt)
+}
+
+func (c *Client) reviseCancelDomesticOrder(ctx context.Context, venue, orderNo, rvseCnclDvsnCd string, qty int, opt *OrderDomesticStockOptions) (*OrderResult, error) {
+	if venue == "" || orderNo == "" {
+		return nil, fmt.Errorf("invalid order: venue=%s, order no=%s", venue, orderNo)
+	}
+
+	if qty < 0 {
+		return nil, fmt.Errorf("invalid qty: %d", qty)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	res, err := c.oc.PostUapiDomesticStockV1TradingOrderRvsecncl(
+		ctx,
+		&oapi.PostUapiDomesticStockV1TradingOrderRvsecnclParams{
+			TrId: c.trID("TTTC0803U"),
+		},
+		oapi.PostUapiDomesticStockV1TradingOrderRvsecnclJSONRequestBody{
+			"CANO":               *cano,
+			"ACNT_PRDT_CD":       fmt.Sprintf("%d", *acntprdtcd),
+			"KRX_FWDG_ORD_ORGNO": venue,                        // 한국거래소전송주문조직번호
+			"ORGN_ODNO":          orderNo,                      // 원주문번호
+			"ORD_DVSN":           opt.getDVSN(),                // 주문구분
+			"RVSE_CNCL_DVSN_CD":  rvseCnclDvsnCd,               // 01: 정정, 02: 취소
+			"ORD_QTY":            fmt.Sprintf("%d", qty),       // 주문수량
+			"ORD_UNPR":           fmt.Sprintf("%d", opt.Price), // 주문단가
+			"QTY_ALL_ORD_YN":     toStr(qty == 0),              // 잔량전부주문여부
+			"EXCG_ID_DVSN_CD":    opt.getExchange(),            // 거래소ID구분코드
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	respData := &uapiDomesticStockV1TradingOrderResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newOrderResult(res, respData, opt.Exchange)
+}
+
+// ListCancellableOrders retrieves the open orders which can be modified or canceled.
+// It follows the continuation keys and returns orders of all pages.
+func (c *Client) ListCancellableOrders(ctx context.Context) ([]*CancellableOrder, error) {
+	if c.env == EnvironmentVTS {
+		return nil, fmt.Errorf("list cancellable orders is not supported in %s", c.env)
+	}
+
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
+	if err != nil {
+		return nil, fmt.Errorf("parse account failed: %w", err)
+	}
+
+	var ret []*CancellableOrder
+	var ctxAreaFK, ctxAreaNK string
+	for {
+		resp, err := c.oc.GetUapiDomesticStockV1TradingInquirePsblRvsecncl(
+			ctx,
+			&oapi.GetUapiDomesticStockV1TradingInquirePsblRvsecnclParams{
+				CANO:         cano,
+				ACNTPRDTCD:   acntprdtcd,
+				CTXAREAFK100: ptr(ctxAreaFK),
+				CTXAREANK100: ptr(ctxAreaNK),
+				INQRDVSN1:    ptr(0), // 0: 조회순서, 1: 주문순, 2: 종목순
+				INQRDVSN2:    ptr(0), // 0: 전체, 1: 매도, 2: 매수
+				TrId:         c.trID("TTTC8036R"),
+			},
+			withTrCont(ctxAreaNK != ""),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		respData := &uapiDomesticStockV1TradingInquirePsblRvsecnclResponse{}
+		err = unmarshalJsonBody(resp.Body, respData)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal response failed: %w", err)
+		}
+
+		orders, err := validateCancellableOrders(resp, respData)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, orders...)
+
+		if !hasNextPage(resp) || len(orders) == 0 ||
+			(respData.CtxAreaFk100 == ctxAreaFK && respData.CtxAreaNk100 == ctxAreaNK) {
+			break
+		}
+		ctxAreaFK, ctxAreaNK = respData.CtxAreaFk100, respData.CtxAreaNk100
+	}
+
+	return ret, nil
+}
+
+type uapiDomesticStockV1TradingInquirePsblRvsecnclResponse struct {
+	Output       []*psblRvsecnclOutput `json:"output"`
+	CtxAreaFk100 string                `json:"ctx_area_fk100"`
+	CtxAreaNk100 string                `json:"ctx_area_nk100"`
+	RtCd         string                `json:"rt_cd"`
+	MsgCd        string                `json:"msg_cd"`
+	Msg1         string                `json:"msg1"`
+}
+
+type psblRvsecnclOutput struct {
+	OrdGnoBrno       string `json:"ord_gno_brno"`        // 주문채번지점번호
+	Odno             string `json:"odno"`                // 주문번호
+	OrgnOdno         string `json:"orgn_odno"`           // 원주문번호
+	OrdDvsnName      string `json:"ord_dvsn_name"`       // 주문구분명
+	Pdno             string `json:"pdno"`                // 상품번호
+	PrdtName         string `json:"prdt_name"`           // 상품명
+	RvseCnclDvsnName string `json:"rvse_cncl_dvsn_name"` // 정정취소구분명
+	OrdQty           string `json:"ord_qty"`             // 주문수량
+	OrdUnpr          string `json:"ord_unpr"`            // 주문단가
+	OrdTmd           string `json:"ord_tmd"`             // 주문시각
+	TotCcldQty       string `json:"tot_ccld_qty"`        // 총체결수량
+	TotCcldAmt       string `json:"tot_ccld_amt"`        // 총체결금액
+	PsblQty          string `json:"psbl_qty"`            // 가능수량
+	SllBuyDvsnCd     string `json:"sll_buy_dvsn_cd"`     // 매도매수구분코드
+	OrdDvsnCd        string `json:"ord_dvsn_cd"`         // 주문구분코드
+	ExcgIDDvsnCd     string `json:"excg_id_dvsn_cd"`     // 거래소ID구분코드
+}
+
+// CancellableOrder represents an open order which can be modified or canceled.
+// Exchange is the exchange of the order to pass to ModifyDomesticOrder and CancelDomesticOrder.
+// It is KRX if the response does not have the exchange.
+type CancellableOrder struct {
+	OrderNo        string    `yaml:"주문번호"`
+	OrigOrderNo    string    `yaml:"원주문번호,omitempty"`
+	Venue          string    `yaml:"주문채번지점번호"`
+	Code           string    `yaml:"종목번호"`
+	Name           string    `yaml:"종목명"`
+	Side           string    `yaml:"매도매수구분"` // 매도, 매수
+	OrderType      string    `yaml:"주문구분"`
+	OrderQty       int       `yaml:"주문수량"`
+	OrderPrice     int       `yaml:"주문단가"`
+	FilledQty      int       `yaml:"총체결수량,omitempty"`
+	FilledAmount   int       `yaml:"총체결금액,omitempty"`
+	CancellableQty int       `yaml:"정정취소가능수량"`
+	OrderedAt      time.Time `yaml:"주문시간"`
+	Exchange       Exchange  `yaml:"거래소ID구분코드"`
+}
+
+func validateCancellableOrders(resp *http.Response, data *uapiDomesticStockV1TradingInquirePsblRvsecnclResponse) ([]*CancellableOrder, error) {
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
+	}
+
+	var ret []*CancellableOrder
+	for _, o := range data.Output {
+		if o == nil || o.Odno == "" {
+			continue
+		}
+		orderedAt, _ := conv.HHMMSSToTime(o.OrdTmd)
+		ex := ExchangeKRX
+		if e := Exchange(o.ExcgIDDvsnCd); e.valid() {
+			ex = e
+		}
+		ret = append(ret, &CancellableOrder{
+			OrderNo:        o.Odno,
+			OrigOrderNo:    o.OrgnOdno,
+			Venue:          o.OrdGnoBrno,
+			Code:           o.Pdno,
+			Name:           o.PrdtName,
+			Side:           conv.SideNames[o.SllBuyDvsnCd],
+			OrderType:      o.OrdDvsnName,
+			OrderQty:       toInt(o.OrdQty),
+			OrderPrice:     toInt(o.OrdUnpr),
+			FilledQty:      toInt(o.TotCcldQty),
+			FilledAmount:   toInt(o.TotCcldAmt),
+			CancellableQty: toInt(o.PsblQty),
+			OrderedAt:      orderedAt,
+			Exchange:       ex,
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,91 @@
+package kinvest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviseCancelDomesticOrder(t *testing.T) {
+	var body map[string]any
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-price":
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output":{"stck_prpr":"71900","stck_mxpr":"93400","stck_llam":"50400","aspr_unit":"100"}}`))
+		case "/uapi/domestic-stock/v1/trading/order-rvsecncl":
+			body = nil
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"APBK0013","msg1":"주문 전송 완료 되었습니다.","output":{"KRX_FWDG_ORD_ORGNO":"91252","ODNO":"0000117058","ORD_TMD":"121052"}}`))
+		case "/uapi/domestic-stock/v1/trading/inquire-psbl-rvsecncl":
+			q := r.URL.Query()
+			fk, nk := q.Get("CTX_AREA_FK100"), q.Get("CTX_AREA_NK100")
+			pages = append(pages, fmt.Sprintf("%s/%s/%s", r.Header.Get("tr_cont"), fk, nk))
+
+			row := `{"ord_gno_brno":"91252","odno":"%s","pdno":"005930","prdt_name":"삼성전자","sll_buy_dvsn_cd":"02","ord_qty":"10","ord_unpr":"71000","psbl_qty":"%s","ord_tmd":"090000"%s}`
+			if nk == "" {
+				w.Header().Set("tr_cont", "F")
+				fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"KIOK0460","msg1":"조회 되었습니다.","ctx_area_fk100":"FK1","ctx_area_nk100":"NK1","output":[`+row+`]}`, "0000000001", "10", `,"excg_id_dvsn_cd":"NXT"`)
+				return
+			}
+			assert.Equal(t, "FK1", fk)
+			w.Header().Set("tr_cont", "D")
+			fmt.Fprintf(w, `{"rt_cd":"0","msg_cd":"KIOK0460","msg1":"조회 되었습니다.","ctx_area_fk100":"FK2","ctx_area_nk100":"NK2","output":[`+row+`]}`, "0000000002", "4", "")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	res, err := c.ModifyDomesticOrder(ctx, "005930", ExchangeNXT, "91252", "0000117057", 3, &OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 72000})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0000117058", res.OrderNo)
+		assert.Equal(t, ExchangeNXT, res.Exchange)
+		assert.Equal(t, "01", body["RVSE_CNCL_DVSN_CD"])
+		assert.Equal(t, "0000117057", body["ORGN_ODNO"])
+		assert.Equal(t, "91252", body["KRX_FWDG_ORD_ORGNO"])
+		assert.Equal(t, "3", body["ORD_QTY"])
+		assert.Equal(t, "72000", body["ORD_UNPR"])
+		assert.Equal(t, "N", body["QTY_ALL_ORD_YN"])
+		assert.Equal(t, "NXT", body["EXCG_ID_DVSN_CD"])
+	}
+
+	// 원주문의 거래소와 다른 거래소로 정정할 수 없다
+	_, err = c.ModifyDomesticOrder(ctx, "005930", ExchangeNXT, "91252", "0000117057", 3, &OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 72000, Exchange: ExchangeKRX})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	res, err = c.CancelDomesticOrder(ctx, ExchangeSOR, "91252", "0000117057", 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ExchangeSOR, res.Exchange)
+		assert.Equal(t, "02", body["RVSE_CNCL_DVSN_CD"])
+		assert.Equal(t, "0000117057", body["ORGN_ODNO"])
+		assert.Equal(t, "91252", body["KRX_FWDG_ORD_ORGNO"])
+		assert.Equal(t, "Y", body["QTY_ALL_ORD_YN"])
+		assert.Equal(t, "SOR", body["EXCG_ID_DVSN_CD"])
+	}
+
+	_, err = c.CancelDomesticOrder(ctx, "", "91252", "0000117057", 0)
+	assert.Error(t, err)
+
+	orders, err := c.ListCancellableOrders(ctx)
+	if assert.NoError(t, err) && assert.Len(t, orders, 2) {
+		assert.Equal(t, "0000000001", orders[0].OrderNo)
+		assert.Equal(t, ExchangeNXT, orders[0].Exchange)
+		assert.Equal(t, ExchangeKRX, orders[1].Exchange) // 거래소가 없으면 KRX
+		assert.Equal(t, "0000000002", orders[1].OrderNo)
+		assert.Equal(t, 4, orders[1].CancellableQty)
+		assert.Equal(t, "매수", orders[1].Side)
+	}
+	// 마지막 페이지(tr_cont D)에서 멈춘다
+	assert.Equal(t, []string{"//", "N/FK1/NK1"}, pages)
+}
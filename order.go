@@ -3,48 +3,47 @@ package kinvest
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 	"time"
 
+	"github.com/suapapa/go_kinvest/internal/conv"
 	"github.com/suapapa/go_kinvest/internal/oapi"
 )
 
 // SellDomesticStock sells domestic(KRX) stock.
+// The order is checked by ValidateDomesticOrder before it is sent.
 func (c *Client) SellDomesticStock(ctx context.Context, code string, qty int, opt *OrderDomesticStockOptions) (*OrderResult, error) {
-	if len(code) != 6 {
-		return nil, fmt.Errorf("invalid item no: %s", code)
-	}
-
-	if qty <= 0 {
-		return nil, fmt.Errorf("invalid qty: %d", qty)
-	}
-
 	if opt == nil {
 		var err error
-		opt, err = NewSellOrderDomesticStockOptions("시장가", "일반매도", 0)
+		opt, err = NewSellOrderOptions(OrderTypeMarket, SellTypeNormal, 0)
 		if err != nil {
-			return nil, fmt.Errorf("create buy option failed: %w", err)
+			return nil, fmt.Errorf("create sell option failed: %w", err)
 		}
 	}
 
-	cano, acntprdtcd, err := parseAccount(c.account)
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
 	if err != nil {
 		return nil, fmt.Errorf("parse account failed: %w", err)
 	}
 
+	if err := c.ValidateDomesticOrder(ctx, code, qty, opt); err != nil {
+		return nil, err
+	}
+
 	res, err := c.oc.PostUapiDomesticStockV1TradingOrderCash(
 		ctx,
 		&oapi.PostUapiDomesticStockV1TradingOrderCashParams{
-			TrId: ptr("TTTC0801U"),
+			TrId: c.trID("TTTC0801U"),
 		},
 		oapi.PostUapiDomesticStockV1TradingOrderCashJSONRequestBody{
-			"CANO":         *cano,
-			"ACNT_PRDT_CD": fmt.Sprintf("%d", *acntprdtcd),
-			"PDNO":         code,                         // 종목코드
-			"ORD_DVSN":     opt.getDVSN(),                // 주문구분
-			"ORD_QTY":      fmt.Sprintf("%d", qty),       // 주문수량
-			"ORD_UNPR":     fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
-			"SLL_TYPE":     opt.getSellTypeCode(),        // 매도유형
+			"CANO":            *cano,
+			"ACNT_PRDT_CD":    fmt.Sprintf("%d", *acntprdtcd),
+			"PDNO":            code,                         // 종목코드
+			"ORD_DVSN":        opt.getDVSN(),                // 주문구분
+			"ORD_QTY":         fmt.Sprintf("%d", qty),       // 주문수량
+			"ORD_UNPR":        fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
+			"SLL_TYPE":        opt.getSellTypeCode(),        // 매도유형
+			"EXCG_ID_DVSN_CD": opt.getExchange(),            // 거래소ID구분코드
 		},
 	)
 	if err != nil {
@@ -52,44 +51,47 @@ func (c *Client) SellDomesticStock(ctx context.Context, code string, qty int, op
 	}
 	defer res.Body.Close()
 
-	return newOrderResult(mustUnmarshalJsonBody(res.Body))
+	respData := &uapiDomesticStockV1TradingOrderResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newOrderResult(res, respData, Exchange(opt.getExchange()))
 }
 
 // BuyDomesticStock buys domestic(KRX) stock.
+// The order is checked by ValidateDomesticOrder before it is sent.
 func (c *Client) BuyDomesticStock(ctx context.Context, code string, qty int, opt *OrderDomesticStockOptions) (*OrderResult, error) {
-	if len(code) != 6 {
-		return nil, fmt.Errorf("invalid item no: %s", code)
-	}
-
-	if qty <= 0 {
-		return nil, fmt.Errorf("invalid qty: %d", qty)
-	}
-
 	if opt == nil {
 		var err error
-		opt, err = NewBuyOrderDomesticStockOptions("시장가", 0)
+		opt, err = NewBuyOrderOptions(OrderTypeMarket, 0)
 		if err != nil {
 			return nil, fmt.Errorf("create buy option failed: %w", err)
 		}
 	}
 
-	cano, acntprdtcd, err := parseAccount(c.account)
+	cano, acntprdtcd, err := c.accountParams(stockProducts)
 	if err != nil {
 		return nil, fmt.Errorf("parse account failed: %w", err)
 	}
 
+	if err := c.ValidateDomesticOrder(ctx, code, qty, opt); err != nil {
+		return nil, err
+	}
+
 	res, err := c.oc.PostUapiDomesticStockV1TradingOrderCash(
 		ctx,
 		&oapi.PostUapiDomesticStockV1TradingOrderCashParams{
-			TrId: ptr("TTTC0802U"),
+			TrId: c.trID("TTTC0802U"),
 		},
 		oapi.PostUapiDomesticStockV1TradingOrderCashJSONRequestBody{
-			"CANO":         *cano,
-			"ACNT_PRDT_CD": fmt.Sprintf("%d", *acntprdtcd),
-			"PDNO":         code,                         // 종목코드
-			"ORD_DVSN":     opt.getDVSN(),                // 주문구분
-			"ORD_QTY":      fmt.Sprintf("%d", qty),       // 주문수량
-			"ORD_UNPR":     fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
+			"CANO":            *cano,
+			"ACNT_PRDT_CD":    fmt.Sprintf("%d", *acntprdtcd),
+			"PDNO":            code,                         // 종목코드
+			"ORD_DVSN":        opt.getDVSN(),                // 주문구분
+			"ORD_QTY":         fmt.Sprintf("%d", qty),       // 주문수량
+			"ORD_UNPR":        fmt.Sprintf("%d", opt.Price), // 주문단가 0: 시장가
+			"EXCG_ID_DVSN_CD": opt.getExchange(),            // 거래소ID구분코드
 		},
 	)
 	if err != nil {
@@ -97,28 +99,31 @@ func (c *Client) BuyDomesticStock(ctx context.Context, code string, qty int, opt
 	}
 	defer res.Body.Close()
 
-	return newOrderResult(mustUnmarshalJsonBody(res.Body))
+	respData := &uapiDomesticStockV1TradingOrderResponse{}
+	if err := unmarshalJsonBody(res.Body, respData); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+
+	return newOrderResult(res, respData, Exchange(opt.getExchange()))
 }
 
 // OrderDomesticStockOptions is the options for domestic stock order.
-// Type is one of following:
-//
-//	지정가, 시장가, 조건부지정가, 최유리지정가, 최우선지정가, 장전 시간외, 장후 시간외, 시간외 단일가,
-//	경매매, 자기주식, 자기주식S-Option, 자기주식금전신탁, IOC지정가, IOC시장가, FOK시장가, IOC최유리, FOK최유리,
-//	장중대량, 장중바스켓, 장개시전 시간외대량, 장개시전 시간외바스켓, 장개시전 금전신탁자사주, 장개시전 자기주식,
-//	시간외대량, 시간외자사주신탁, 시간외대량자기주식, 바스켓, 중간가, 스톱지정가, 중간가IOC, 중간가FOK
-//
-// SellType will be ignored when buy and should be set one of following when sell:
+// Type is one of the OrderType constants, such as OrderTypeLimit and OrderTypeMarket.
+// SellType will be ignored when buy and should be one of the SellType constants when sell.
+// Exchange routes the order to KRX, NXT or SOR. KRX is used if it is empty.
 //
-//	일반매도, 임의매도, 대차매도
+// Type and SellType were the strings of the Korean names, such as "지정가", and now are the typed codes.
+// This breaks the code assigning a string variable to them; convert it with ParseOrderType and ParseSellType,
+// or use NewBuyOrderDomesticStockOptions and NewSellOrderDomesticStockOptions which still take the names.
 type OrderDomesticStockOptions struct {
-	Type     string // 주문구분
-	Price    int    // 주문단가
-	SellType string // 매도구분 : 일반매도, 임의매도, 대차매도
+	Type     OrderType // 주문구분
+	Price    int       // 주문단가
+	SellType SellType  // 매도구분
+	Exchange Exchange  // 거래소ID구분코드
 }
 
-// NewBuyOrderDomesticStockOptions creates a new BuyDomesticStock.
-func NewBuyOrderDomesticStockOptions(orderType string, orderPrice int) (*OrderDomesticStockOptions, error) {
+// NewBuyOrderOptions creates a new OrderDomesticStockOptions for BuyDomesticStock.
+func NewBuyOrderOptions(orderType OrderType, orderPrice int) (*OrderDomesticStockOptions, error) {
 	opt, err := newOrderDomesticStockOptions(orderType, "", orderPrice)
 	if err != nil {
 		return nil, fmt.Errorf("create buy option failed: %w", err)
@@ -126,8 +131,8 @@ func NewBuyOrderDomesticStockOptions(orderType string, orderPrice int) (*OrderDo
 	return opt, nil
 }
 
-// NewSellOrderDomesticStockOptions creates a new SellDomesticStock.
-func NewSellOrderDomesticStockOptions(orderType, sellType string, orderPrice int) (*OrderDomesticStockOptions, error) {
+// NewSellOrderOptions creates a new OrderDomesticStockOptions for SellDomesticStock.
+func NewSellOrderOptions(orderType OrderType, sellType SellType, orderPrice int) (*OrderDomesticStockOptions, error) {
 	opt, err := newOrderDomesticStockOptions(orderType, sellType, orderPrice)
 	if err != nil {
 		return nil, fmt.Errorf("create sell option failed: %w", err)
@@ -135,140 +140,114 @@ func NewSellOrderDomesticStockOptions(orderType, sellType string, orderPrice int
 	return opt, nil
 }
 
-func newOrderDomesticStockOptions(orderType, sellType string, orderPrice int) (*OrderDomesticStockOptions, error) {
-	if orderPrice < 0 {
-		return nil, fmt.Errorf("invalid order price: %d", orderPrice)
+// NewBuyOrderDomesticStockOptions creates a new BuyDomesticStock.
+// orderType is the Korean name of the order type, such as 시장가 or 지정가.
+func NewBuyOrderDomesticStockOptions(orderType string, orderPrice int) (*OrderDomesticStockOptions, error) {
+	ot, err := ParseOrderType(orderType)
+	if err != nil {
+		return nil, fmt.Errorf("create buy option failed: %w", err)
 	}
+	return NewBuyOrderOptions(ot, orderPrice)
+}
 
-	if _, ok := dvsnCodes[orderType]; !ok {
-		var orderTypes []string
-		for k := range dvsnCodes {
-			orderTypes = append(orderTypes, k)
-		}
-		return nil, fmt.Errorf("invalid order type: %s, set one of the following: %s", orderType, strings.Join(orderTypes, ", "))
+// NewSellOrderDomesticStockOptions creates a new SellDomesticStock.
+// orderType and sellType are the Korean names, such as 시장가 and 일반매도.
+func NewSellOrderDomesticStockOptions(orderType, sellType string, orderPrice int) (*OrderDomesticStockOptions, error) {
+	ot, err := ParseOrderType(orderType)
+	if err != nil {
+		return nil, fmt.Errorf("create sell option failed: %w", err)
+	}
+	st, err := ParseSellType(sellType)
+	if err != nil {
+		return nil, fmt.Errorf("create sell option failed: %w", err)
 	}
+	return NewSellOrderOptions(ot, st, orderPrice)
+}
 
-	if sellType != "" {
-		if _, ok := sellTypeCode[sellType]; !ok {
-			var sellTypeCodes []string
-			for k := range sellTypeCode {
-				sellTypeCodes = append(sellTypeCodes, k)
-			}
-			return nil, fmt.Errorf("invalid sell type: %s, set one of the following: %s", sellType, strings.Join(sellTypeCodes, ", "))
-		}
+func newOrderDomesticStockOptions(orderType OrderType, sellType SellType, orderPrice int) (*OrderDomesticStockOptions, error) {
+	if !orderType.valid() {
+		return nil, fmt.Errorf("invalid order type: %s, set one of the following: %s", orderType, joinNames(orderTypes))
 	}
 
-	return &OrderDomesticStockOptions{
+	if sellType != "" && !sellType.valid() {
+		return nil, fmt.Errorf("invalid sell type: %s, set one of the following: %s", sellType, joinNames(sellTypes))
+	}
+
+	opt := &OrderDomesticStockOptions{
 		Type:     orderType,
 		Price:    orderPrice,
 		SellType: sellType,
-	}, nil
-}
-
-func (o *OrderDomesticStockOptions) getDVSN() string {
-	if code, ok := dvsnCodes[o.Type]; ok {
-		return code
-	} else {
-		return dvsnCodes["지정가"]
 	}
+	if err := opt.validate(); err != nil {
+		return nil, err
+	}
+	return opt, nil
 }
 
-var dvsnCodes = orderType{
-	"지정가":          "00",
-	"시장가":          "01",
-	"조건부지정가":       "02",
-	"최유리지정가":       "03",
-	"최우선지정가":       "04",
-	"장전 시간외":       "05",
-	"장후 시간외":       "06",
-	"시간외 단일가":      "07",
-	"경매매":          "65",
-	"자기주식":         "08",
-	"자기주식S-Option": "09",
-	"자기주식금전신탁":     "10",
-	"IOC지정가":       "11", // 즉시체결,잔량취소
-	"IOC시장가":       "13", // 즉시체결,잔량취소
-	"FOK시장가":       "14", // 즉시체결,잔량취소
-	"IOC최유리":       "15", // 즉시체결,잔량취소
-	"FOK최유리":       "16", // 즉시체결,잔량취소
-	"장중대량":         "51",
-	"장중바스켓":        "52",
-	"장개시전 시간외대량":   "62",
-	"장개시전 시간외바스켓":  "63",
-	"장개시전 금전신탁자사주": "67",
-	"장개시전 자기주식":    "69",
-	"시간외대량":        "72",
-	"시간외자사주신탁":     "77",
-	"시간외대량자기주식":    "79",
-	"바스켓":          "80",
-	"중간가":          "21",
-	"스톱지정가":        "22",
-	"중간가IOC":       "23",
-	"중간가FOK":       "24",
+// getDVSN returns the order type code. The options should be validated first.
+func (o *OrderDomesticStockOptions) getDVSN() string {
+	return string(o.Type)
 }
 
 func (o *OrderDomesticStockOptions) getSellTypeCode() string {
-	if code, ok := sellTypeCode[o.SellType]; ok {
-		return code
+	if o.SellType.valid() {
+		return string(o.SellType)
 	}
-	return sellTypeCode["일반매도"]
+	return string(SellTypeNormal)
 }
 
-var sellTypeCode = map[string]string{
-	"일반매도": "01",
-	"임의매도": "02",
-	"대차매도": "05",
+func (o *OrderDomesticStockOptions) getExchange() string {
+	if o.Exchange.valid() {
+		return string(o.Exchange)
+	}
+	return string(ExchangeKRX)
 }
 
-type orderType map[string]string
-
-func (o orderType) OrdDvsnCode(ot string) (string, error) {
-	if code, ok := o[ot]; ok {
-		return code, nil
-	}
-	for k, v := range o {
-		if strings.Contains(k, ot) {
-			return v, nil
-		}
-	}
+// uapiDomesticStockV1TradingOrderResponse is the response of the cash, credit and revise/cancel orders.
+type uapiDomesticStockV1TradingOrderResponse struct {
+	Output *orderOutput `json:"output"`
+	RtCd   string       `json:"rt_cd"`
+	MsgCd  string       `json:"msg_cd"`
+	Msg1   string       `json:"msg1"`
+}
 
-	return "", fmt.Errorf("order type not found: %s", ot)
+type orderOutput struct {
+	KrxFwdgOrdOrgno string `json:"KRX_FWDG_ORD_ORGNO"` // 한국거래소전송주문조직번호
+	Odno            string `json:"ODNO"`               // 주문번호
+	OrdTmd          string `json:"ORD_TMD"`            // 주문시각
 }
 
-func newOrderResult(data map[string]any) (*OrderResult, error) {
+func newOrderResult(resp *http.Response, data *uapiDomesticStockV1TradingOrderResponse, ex Exchange) (*OrderResult, error) {
 	if data == nil {
 		return nil, fmt.Errorf("response is nil")
 	}
-	if data["rt_cd"] != "0" {
-		return nil, fmt.Errorf("response error: %s (%s)", data["msg1"], data["msg_cd"])
+	if data.RtCd != "0" {
+		return nil, newAPIError(resp, data.RtCd, data.MsgCd, data.Msg1)
 	}
 
-	if output, ok := data["output"].(map[string]any); ok {
-		ordNo := output["ODNO"].(string)
-		ordTimeStr := output["ORD_TMD"].(string)
-		venue := output["KRX_FWDG_ORD_ORGNO"].(string)
-		if ordNo == "" || ordTimeStr == "" || venue == "" {
-			return nil, fmt.Errorf("response output is nil")
-		}
-
-		ordTime, err := hhmmssToTime(ordTimeStr)
-		if err != nil {
-			return nil, fmt.Errorf("convert order time failed: %w", err)
-		}
+	output := data.Output
+	if output == nil || output.Odno == "" || output.OrdTmd == "" || output.KrxFwdgOrdOrgno == "" {
+		return nil, fmt.Errorf("response output is nil")
+	}
 
-		return &OrderResult{
-			OrderNo:   ordNo,
-			OrderedAt: ordTime,
-			Venue:     venue,
-		}, nil
+	ordTime, err := conv.HHMMSSToTime(output.OrdTmd)
+	if err != nil {
+		return nil, fmt.Errorf("convert order time failed: %w", err)
 	}
 
-	return nil, fmt.Errorf("response output is not a map")
+	return &OrderResult{
+		OrderNo:   output.Odno,
+		OrderedAt: ordTime,
+		Venue:     output.KrxFwdgOrdOrgno,
+		Exchange:  ex,
+	}, nil
 }
 
 // OrderResult is the result of the order.
+// Exchange is the exchange the order is routed to, which is needed to modify or cancel the order.
 type OrderResult struct {
 	OrderNo   string    `yaml:"주문번호"`
 	OrderedAt time.Time `yaml:"주문시간"`
 	Venue     string    `yaml:"거래소코드"`
+	Exchange  Exchange  `yaml:"거래소ID구분코드"`
 }
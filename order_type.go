@@ -0,0 +1,198 @@
+package kinvest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderType is the order type code (주문구분) of the domestic stock order.
+type OrderType string
+
+const (
+	OrderTypeLimit                 OrderType = "00" // 지정가
+	OrderTypeMarket                OrderType = "01" // 시장가
+	OrderTypeConditionalLimit      OrderType = "02" // 조건부지정가
+	OrderTypeBestLimit             OrderType = "03" // 최유리지정가
+	OrderTypePriorityLimit         OrderType = "04" // 최우선지정가
+	OrderTypePreMarketOvertime     OrderType = "05" // 장전 시간외
+	OrderTypeAfterMarketOvertime   OrderType = "06" // 장후 시간외
+	OrderTypeOvertimeSinglePrice   OrderType = "07" // 시간외 단일가
+	OrderTypeTreasury              OrderType = "08" // 자기주식
+	OrderTypeTreasurySOption       OrderType = "09" // 자기주식S-Option
+	OrderTypeTreasuryTrust         OrderType = "10" // 자기주식금전신탁
+	OrderTypeIOCLimit              OrderType = "11" // IOC지정가 (즉시체결, 잔량취소)
+	OrderTypeFOKLimit              OrderType = "12" // FOK지정가 (즉시체결, 전량취소)
+	OrderTypeIOCMarket             OrderType = "13" // IOC시장가 (즉시체결, 잔량취소)
+	OrderTypeFOKMarket             OrderType = "14" // FOK시장가 (즉시체결, 전량취소)
+	OrderTypeIOCBest               OrderType = "15" // IOC최유리 (즉시체결, 잔량취소)
+	OrderTypeFOKBest               OrderType = "16" // FOK최유리 (즉시체결, 전량취소)
+	OrderTypeMid                   OrderType = "21" // 중간가
+	OrderTypeStopLimit             OrderType = "22" // 스톱지정가
+	OrderTypeMidIOC                OrderType = "23" // 중간가IOC
+	OrderTypeMidFOK                OrderType = "24" // 중간가FOK
+	OrderTypeBlock                 OrderType = "51" // 장중대량
+	OrderTypeBasket                OrderType = "52" // 장중바스켓
+	OrderTypePreMarketBlock        OrderType = "62" // 장개시전 시간외대량
+	OrderTypePreMarketBasket       OrderType = "63" // 장개시전 시간외바스켓
+	OrderTypeAuction               OrderType = "65" // 경매매
+	OrderTypePreMarketTrust        OrderType = "67" // 장개시전 금전신탁자사주
+	OrderTypePreMarketTreasury     OrderType = "69" // 장개시전 자기주식
+	OrderTypeOvertimeBlock         OrderType = "72" // 시간외대량
+	OrderTypeOvertimeTrust         OrderType = "77" // 시간외자사주신탁
+	OrderTypeOvertimeBlockTreasury OrderType = "79" // 시간외대량자기주식
+	OrderTypeOvertimeBasket        OrderType = "80" // 바스켓
+)
+
+// orderTypes keeps the order of the order types for the error messages.
+var orderTypes = []OrderType{
+	OrderTypeLimit, OrderTypeMarket, OrderTypeConditionalLimit, OrderTypeBestLimit, OrderTypePriorityLimit,
+	OrderTypePreMarketOvertime, OrderTypeAfterMarketOvertime, OrderTypeOvertimeSinglePrice,
+	OrderTypeTreasury, OrderTypeTreasurySOption, OrderTypeTreasuryTrust,
+	OrderTypeIOCLimit, OrderTypeFOKLimit, OrderTypeIOCMarket, OrderTypeFOKMarket, OrderTypeIOCBest, OrderTypeFOKBest,
+	OrderTypeMid, OrderTypeStopLimit, OrderTypeMidIOC, OrderTypeMidFOK,
+	OrderTypeBlock, OrderTypeBasket, OrderTypePreMarketBlock, OrderTypePreMarketBasket, OrderTypeAuction,
+	OrderTypePreMarketTrust, OrderTypePreMarketTreasury,
+	OrderTypeOvertimeBlock, OrderTypeOvertimeTrust, OrderTypeOvertimeBlockTreasury, OrderTypeOvertimeBasket,
+}
+
+var orderTypeNames = map[OrderType]string{
+	OrderTypeLimit:                 "지정가",
+	OrderTypeMarket:                "시장가",
+	OrderTypeConditionalLimit:      "조건부지정가",
+	OrderTypeBestLimit:             "최유리지정가",
+	OrderTypePriorityLimit:         "최우선지정가",
+	OrderTypePreMarketOvertime:     "장전 시간외",
+	OrderTypeAfterMarketOvertime:   "장후 시간외",
+	OrderTypeOvertimeSinglePrice:   "시간외 단일가",
+	OrderTypeTreasury:              "자기주식",
+	OrderTypeTreasurySOption:       "자기주식S-Option",
+	OrderTypeTreasuryTrust:         "자기주식금전신탁",
+	OrderTypeIOCLimit:              "IOC지정가",
+	OrderTypeFOKLimit:              "FOK지정가",
+	OrderTypeIOCMarket:             "IOC시장가",
+	OrderTypeFOKMarket:             "FOK시장가",
+	OrderTypeIOCBest:               "IOC최유리",
+	OrderTypeFOKBest:               "FOK최유리",
+	OrderTypeMid:                   "중간가",
+	OrderTypeStopLimit:             "스톱지정가",
+	OrderTypeMidIOC:                "중간가IOC",
+	OrderTypeMidFOK:                "중간가FOK",
+	OrderTypeBlock:                 "장중대량",
+	OrderTypeBasket:                "장중바스켓",
+	OrderTypePreMarketBlock:        "장개시전 시간외대량",
+	OrderTypePreMarketBasket:       "장개시전 시간외바스켓",
+	OrderTypeAuction:               "경매매",
+	OrderTypePreMarketTrust:        "장개시전 금전신탁자사주",
+	OrderTypePreMarketTreasury:     "장개시전 자기주식",
+	OrderTypeOvertimeBlock:         "시간외대량",
+	OrderTypeOvertimeTrust:         "시간외자사주신탁",
+	OrderTypeOvertimeBlockTreasury: "시간외대량자기주식",
+	OrderTypeOvertimeBasket:        "바스켓",
+}
+
+// ParseOrderType returns the OrderType of the Korean name, such as 시장가 or 지정가.
+func ParseOrderType(name string) (OrderType, error) {
+	for _, t := range orderTypes {
+		if orderTypeNames[t] == name {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("invalid order type: %s, set one of the following: %s", name, joinNames(orderTypes))
+}
+
+// String returns the Korean name of the order type.
+func (t OrderType) String() string {
+	if name, ok := orderTypeNames[t]; ok {
+		return name
+	}
+	return string(t)
+}
+
+// MarshalYAML marshals the order type in its Korean name.
+func (t OrderType) MarshalYAML() (any, error) {
+	return t.String(), nil
+}
+
+// IsMarket reports whether the price of the order type is decided by the market,
+// such as 시장가, 최유리지정가 and 장전 시간외. The order price of them should be 0.
+func (t OrderType) IsMarket() bool {
+	switch t {
+	case OrderTypeMarket, OrderTypeBestLimit, OrderTypePriorityLimit,
+		OrderTypePreMarketOvertime, OrderTypeAfterMarketOvertime,
+		OrderTypeIOCMarket, OrderTypeFOKMarket, OrderTypeIOCBest, OrderTypeFOKBest,
+		OrderTypeMid, OrderTypeMidIOC, OrderTypeMidFOK:
+		return true
+	default:
+		return false
+	}
+}
+
+// isLimit reports whether the order type is the regular limit order which should be on the tick grid.
+func (t OrderType) isLimit() bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeConditionalLimit, OrderTypeOvertimeSinglePrice,
+		OrderTypeIOCLimit, OrderTypeFOKLimit, OrderTypeStopLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t OrderType) valid() bool {
+	_, ok := orderTypeNames[t]
+	return ok
+}
+
+// SellType is the sell type code (매도유형) of the domestic stock order.
+type SellType string
+
+const (
+	SellTypeNormal        SellType = "01" // 일반매도
+	SellTypeDiscretionary SellType = "02" // 임의매도
+	SellTypeLending       SellType = "05" // 대차매도
+)
+
+var sellTypes = []SellType{SellTypeNormal, SellTypeDiscretionary, SellTypeLending}
+
+var sellTypeNames = map[SellType]string{
+	SellTypeNormal:        "일반매도",
+	SellTypeDiscretionary: "임의매도",
+	SellTypeLending:       "대차매도",
+}
+
+// ParseSellType returns the SellType of the Korean name, such as 일반매도.
+func ParseSellType(name string) (SellType, error) {
+	for _, t := range sellTypes {
+		if sellTypeNames[t] == name {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("invalid sell type: %s, set one of the following: %s", name, joinNames(sellTypes))
+}
+
+// String returns the Korean name of the sell type.
+func (t SellType) String() string {
+	if name, ok := sellTypeNames[t]; ok {
+		return name
+	}
+	return string(t)
+}
+
+// MarshalYAML marshals the sell type in its Korean name.
+func (t SellType) MarshalYAML() (any, error) {
+	return t.String(), nil
+}
+
+func (t SellType) valid() bool {
+	_, ok := sellTypeNames[t]
+	return ok
+}
+
+// joinNames joins the Korean names of the values in order.
+func joinNames[T fmt.Stringer](values []T) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.String()
+	}
+	return strings.Join(names, ", ")
+}
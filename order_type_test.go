@@ -0,0 +1,58 @@
+package kinvest
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrderType(t *testing.T) {
+	for _, ot := range orderTypes {
+		parsed, err := ParseOrderType(ot.String())
+		assert.NoError(t, err)
+		assert.Equal(t, ot, parsed)
+	}
+
+	_, err1 := ParseOrderType("시장기")
+	_, err2 := ParseOrderType("시장기")
+	if assert.Error(t, err1) {
+		// 에러 메시지의 순서가 매번 같아야 한다
+		assert.Equal(t, err1.Error(), err2.Error())
+		assert.Contains(t, err1.Error(), "지정가, 시장가, 조건부지정가")
+	}
+}
+
+func TestOrderOptionsWrappers(t *testing.T) {
+	opt, err := NewSellOrderDomesticStockOptions("IOC지정가", "대차매도", 70000)
+	if assert.NoError(t, err) {
+		assert.Equal(t, OrderTypeIOCLimit, opt.Type)
+		assert.Equal(t, SellTypeLending, opt.SellType)
+		assert.Equal(t, "11", opt.getDVSN())
+		assert.Equal(t, "05", opt.getSellTypeCode())
+	}
+
+	_, err = NewSellOrderDomesticStockOptions("지정가", "매도", 70000)
+	assert.Error(t, err)
+
+	_, err = NewBuyOrderOptions(OrderType("지정가"), 70000)
+	assert.Error(t, err)
+
+	// 빈 주문구분이나 이름으로 쓴 주문구분은 지정가로 보내지 않고 거부한다
+	empty := &OrderDomesticStockOptions{Price: 70000}
+	assert.ErrorIs(t, empty.validate(), ErrInvalidOrder)
+	assert.ErrorIs(t, (&OrderDomesticStockOptions{Type: "지정가", Price: 70000}).validate(), ErrInvalidOrder)
+	// 빈 매도구분은 일반매도로 보낸다
+	assert.Equal(t, "01", empty.getSellTypeCode())
+
+	hopt, err := NewGetDomesticHoldingsOptions("NXT정규장", "대출일별")
+	if assert.NoError(t, err) {
+		assert.Equal(t, SessionNXT, hopt.TradingSessionType)
+		assert.Equal(t, "X", *hopt.tradingSessionTypeCode())
+		assert.Equal(t, 1, *hopt.queryTypeCode())
+
+		out, err := yaml.Marshal(hopt)
+		assert.NoError(t, err)
+		assert.Contains(t, string(out), "거래세션유형: NXT정규장")
+	}
+}
@@ -0,0 +1,123 @@
+package kinvest
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ValidateDomesticOrder checks the order locally before it is sent to KIS.
+// It rejects the market orders with a price, the limit orders without a price or off the tick grid,
+// and the limit orders outside of the day's price limits (상한가, 하한가).
+// The price limits are queried once a day per stock with GetDomesticInquirePrice.
+// The returned error wraps ErrInvalidOrder if the order is rejected.
+func (c *Client) ValidateDomesticOrder(ctx context.Context, code string, qty int, opt *OrderDomesticStockOptions) error {
+	if qty <= 0 {
+		return fmt.Errorf("%w: invalid qty: %d", ErrInvalidOrder, qty)
+	}
+
+	return c.validateDomesticOrderPrice(ctx, code, opt)
+}
+
+// validateDomesticOrderPrice checks the order options and price of the order without qty,
+// which is also used for the modify order.
+func (c *Client) validateDomesticOrderPrice(ctx context.Context, code string, opt *OrderDomesticStockOptions) error {
+	if len(code) != 6 {
+		return fmt.Errorf("%w: invalid item no: %s", ErrInvalidOrder, code)
+	}
+
+	if err := opt.validate(); err != nil {
+		return err
+	}
+	if c.env == EnvironmentVTS && opt.getExchange() != string(ExchangeKRX) {
+		return fmt.Errorf("%w: %s order is not supported in %s", ErrInvalidOrder, opt.Exchange, c.env)
+	}
+	if !opt.Type.isLimit() {
+		return nil
+	}
+
+	limit, err := c.getPriceLimit(ctx, code)
+	if err != nil {
+		return fmt.Errorf("get price limit failed: %w", err)
+	}
+
+	tick := TickSize(opt.Price)
+	if limit.tick > 0 && limit.tick != int64(TickSize(int(limit.price))) {
+		// ETF 처럼 주식과 호가단위가 다른 종목은 현재 호가단위를 따른다
+		tick = int(limit.tick)
+	}
+	if opt.Price%tick != 0 {
+		return fmt.Errorf("%w: price %d is not on the tick grid of %d", ErrInvalidOrder, opt.Price, tick)
+	}
+
+	if limit.upper > 0 && int64(opt.Price) > limit.upper {
+		return fmt.Errorf("%w: price %d is above the upper limit %d", ErrInvalidOrder, opt.Price, limit.upper)
+	}
+	if limit.lower > 0 && int64(opt.Price) < limit.lower {
+		return fmt.Errorf("%w: price %d is below the lower limit %d", ErrInvalidOrder, opt.Price, limit.lower)
+	}
+
+	return nil
+}
+
+// validate checks the options without the market data.
+func (o *OrderDomesticStockOptions) validate() error {
+	if o == nil {
+		return fmt.Errorf("%w: order option is nil", ErrInvalidOrder)
+	}
+
+	if !o.Type.valid() {
+		return fmt.Errorf("%w: invalid order type: %s, set one of the following: %s", ErrInvalidOrder, o.Type, joinNames(orderTypes))
+	}
+
+	if o.Price < 0 {
+		return fmt.Errorf("%w: invalid order price: %d", ErrInvalidOrder, o.Price)
+	}
+
+	if o.Exchange != "" && !o.Exchange.valid() {
+		return fmt.Errorf("%w: invalid exchange: %s, set one of the following: %s", ErrInvalidOrder, o.Exchange, joinNames(exchanges))
+	}
+
+	if o.Type.IsMarket() && o.Price != 0 {
+		return fmt.Errorf("%w: %s order with price %d", ErrInvalidOrder, o.Type, o.Price)
+	}
+
+	if o.Type.isLimit() && o.Price == 0 {
+		return fmt.Errorf("%w: %s order without price", ErrInvalidOrder, o.Type)
+	}
+
+	return nil
+}
+
+type priceLimit struct {
+	day   time.Time
+	upper int64 // 상한가
+	lower int64 // 하한가
+	price int64 // 조회 시점의 현재가
+	tick  int64 // 현재가의 호가단위
+}
+
+func (c *Client) getPriceLimit(ctx context.Context, code string) (*priceLimit, error) {
+	today := truncateDate(time.Now())
+	priceLimits := &c.root().priceLimits
+	if v, ok := priceLimits.Load(code); ok {
+		if limit := v.(*priceLimit); limit.day.Equal(today) {
+			return limit, nil
+		}
+	}
+
+	p, err := c.GetDomesticInquirePrice(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := &priceLimit{
+		day:   today,
+		upper: p.StckMxpr,
+		lower: p.StckLlam,
+		price: p.StckPrpr,
+		tick:  p.AsprUnit,
+	}
+	priceLimits.Store(code, limit)
+	return limit, nil
+}
@@ -0,0 +1,70 @@
+package kinvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDomesticOrder(t *testing.T) {
+	var priceCnt atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/tokenP":
+			w.Write([]byte(`{"access_token":"test-token","token_type":"Bearer","expires_in":86400}`))
+		case "/uapi/domestic-stock/v1/quotations/inquire-price":
+			priceCnt.Add(1)
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다.","output":{"stck_prpr":"71900","stck_mxpr":"93400","stck_llam":"50400","aspr_unit":"100"}}`))
+		case "/uapi/domestic-stock/v1/trading/order-cash", "/uapi/domestic-stock/v1/trading/order-rvsecncl":
+			t.Error("invalid order should not be sent")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	tests := []struct {
+		opt   *OrderDomesticStockOptions
+		valid bool
+	}{
+		{&OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 72000}, true},
+		{&OrderDomesticStockOptions{Type: OrderTypeIOCLimit, Price: 93400}, true},
+		{&OrderDomesticStockOptions{Type: OrderTypeMarket}, true},
+		{&OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 71950}, false}, // 호가단위
+		{&OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 93500}, false}, // 상한가 초과
+		{&OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 50000}, false}, // 하한가 미만
+		{&OrderDomesticStockOptions{Type: OrderTypeLimit}, false},
+		{&OrderDomesticStockOptions{Type: OrderTypeMarket, Price: 72000}, false},
+		{&OrderDomesticStockOptions{Type: OrderTypeFOKBest, Price: 72000}, false},
+		{&OrderDomesticStockOptions{Type: "지정가", Price: 71950}, false},
+		{&OrderDomesticStockOptions{Price: 71950}, false},
+	}
+	for _, tt := range tests {
+		err := c.ValidateDomesticOrder(ctx, "005930", 1, tt.opt)
+		if tt.valid {
+			assert.NoError(t, err, "%s %d", tt.opt.Type, tt.opt.Price)
+		} else {
+			assert.True(t, errors.Is(err, ErrInvalidOrder), "%s %d: %v", tt.opt.Type, tt.opt.Price, err)
+		}
+	}
+	// 상하한가는 하루에 한 번만 조회한다
+	assert.Equal(t, int32(1), priceCnt.Load())
+
+	_, err := c.BuyDomesticStock(ctx, "005930", 1, &OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 71950})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	// 정정 주문의 새 가격도 같은 검사를 한다
+	_, err = c.ModifyDomesticOrder(ctx, "005930", ExchangeKRX, "91252", "0000117057", 0, &OrderDomesticStockOptions{Type: OrderTypeLimit, Price: 93500})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	_, err = NewBuyOrderDomesticStockOptions("시장가", 72000)
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+}
@@ -0,0 +1,74 @@
+package kinvest
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// fuzzParse decodes the body as the response of T and parses it, which should never panic.
+func fuzzParse[T, R any](body []byte, parse func(*http.Response, *T) (R, error)) {
+	data := new(T)
+	if err := unmarshalJsonBody(bytes.NewReader(body), data); err != nil {
+		return
+	}
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	parse(resp, data)
+}
+
+func FuzzResponseParsers(f *testing.F) {
+	for _, seed := range []string{
+		`<html><body>502 Bad Gateway</body></html>`,
+		`{}`,
+		`null`,
+		`{"rt_cd":"1","msg_cd":"EGW00201","msg1":"초당 거래건수를 초과하였습니다."}`,
+		`{"access_token":"token","token_type":"Bearer","expires_in":86400}`,
+		`{"access_token":1,"expires_in":"86400"}`,
+		`{"rt_cd":"0","output":{"KRX_FWDG_ORD_ORGNO":"91252","ODNO":"0000117057","ORD_TMD":"121052"}}`,
+		`{"rt_cd":"0","output":{"ODNO":"0000117057","ORD_TMD":"99"}}`,
+		`{"rt_cd":"0","output":null}`,
+		`{"rt_cd":"0","ctx_area_fk100":"","ctx_area_nk100":"","output1":[null,{"pdno":"005930","hldg_qty":"10","loan_dt":"2024"}],"output2":[{"dnca_tot_amt":"1000"}]}`,
+		`{"rt_cd":"0","ctx_area_fk100":null,"output1":{"pdno":"005930"}}`,
+		`{"rt_cd":"0","output1":[{"pchs_amt":"1"}],"output2":{"tot_asst_amt":"1"}}`,
+		`{"rt_cd":"0","output":{"stck_prpr":"71900","stck_mxpr":"93400"}}`,
+		`{"rt_cd":"0","output1":{"askp1":"72000"},"output2":{"stck_prpr":"71900"}}`,
+		`{"rt_cd":"0","output2":[{"stck_bsop_date":"20250616","stck_cntg_hour":"0930"}]}`,
+		`{"rt_cd":"0","ctx_area_nk100":"NK1","output1":[null,{"odno":"0000000001","ord_dt":"2025","ord_qty":"x","cncl_yn":"Y"}],"output2":{"tot_ord_qty":"10"}}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		fuzzParse(body, func(_ *http.Response, data *oauth2TokenPResponse) (*Token, error) { return newToken(data) })
+		fuzzParse(body, func(resp *http.Response, data *uapiDomesticStockV1TradingOrderResponse) (*OrderResult, error) {
+			return newOrderResult(resp, data, ExchangeKRX)
+		})
+		fuzzParse(body, func(resp *http.Response, data *uapiDomesticStockV1TradingInquireBalanceResponse) (*GetDomesticHoldingsResult, error) {
+			return newGetDomesticHoldingsResult(nil, nil, resp, data)
+		})
+		fuzzParse(body, func(_ *http.Response, data *uapiDomesticStockV1TradingInquireAccountBalanceResp) (*DomesticAccountBalance, error) {
+			return NewDomesticAccountBalance(data)
+		})
+		fuzzParse(body, validateDomesticInquirePriceResp)
+		fuzzParse(body, validateDomesticInquirePrice2)
+		fuzzParse(body, validateDomesticInquireCcnlResp)
+		fuzzParse(body, validateDomesticOrderBook)
+		fuzzParse(body, validateDomesticCandles)
+		fuzzParse(body, validateDomesticMinuteBars)
+		fuzzParse(body, validateDomesticItemInfo)
+		fuzzParse(body, validateBuyingPower)
+		fuzzParse(body, validateSellableQty)
+		fuzzParse(body, func(resp *http.Response, data *uapiDomesticStockV1TradingInquireDailyCcldResponse) (*GetDomesticDailyExecutionsResult, error) {
+			return newGetDomesticDailyExecutionsResult(nil, time.Time{}, time.Time{}, nil, resp, data)
+		})
+		fuzzParse(body, validateReservedOrders)
+		fuzzParse(body, validateCancellableOrders)
+		fuzzParse(body, validateDomesticFinanceBalanceSheet)
+		fuzzParse(body, validateDomesticFinanceIncomeStatement)
+		fuzzParse(body, validateDomesticFinanceFinancialRatio)
+		fuzzParse(body, validateDomesticFinanceGrowthRatio)
+		fuzzParse(body, validateDomesticFinanceProfitRatio)
+		fuzzParse(body, validateDomesticFinanceStabilityRatio)
+	})
+}
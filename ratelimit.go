@@ -0,0 +1,100 @@
+package kinvest
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// KIS 초당 거래건수 제한. 초과하면 EGW00201 에러를 응답한다
+const (
+	defaultProdRateLimit = 20
+	defaultVtsRateLimit  = 2
+)
+
+// rateLimiter is a token bucket which allows rate requests per second.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// 토큰을 미리 예약하고, 모자란 만큼 기다린다
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// rateLimitedDoer waits for the rate limiter before sending each request.
+type rateLimitedDoer struct {
+	doer    oapi.HttpRequestDoer
+	limiter *rateLimiter
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return d.doer.Do(req)
+}
+
+func newRateLimitedDoer(doer oapi.HttpRequestDoer, env Environment, rateLimit float64) oapi.HttpRequestDoer {
+	switch {
+	case rateLimit < 0:
+		return doer
+	case rateLimit == 0:
+		rateLimit = defaultProdRateLimit
+		if env == EnvironmentVTS {
+			rateLimit = defaultVtsRateLimit
+		}
+	}
+
+	// burst 를 허용하면 1초 구간 안에 제한을 넘을 수 있으므로 요청 간격을 고르게 둔다
+	return &rateLimitedDoer{
+		doer:    doer,
+		limiter: newRateLimiter(rateLimit, 1),
+	}
+}
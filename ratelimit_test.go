@@ -0,0 +1,27 @@
+package kinvest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(100, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 5 {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	// 첫 요청은 바로, 나머지 4개는 10ms 간격
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+
+	l = newRateLimiter(1, 1)
+	assert.NoError(t, l.Wait(ctx))
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
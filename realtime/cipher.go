@@ -0,0 +1,48 @@
+package realtime
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"fmt"
+)
+
+// cipherKey is the AES-256-CBC key and iv given in the subscribe response.
+// The fill notices (H0STCNI0) are encrypted with it.
+type cipherKey struct {
+	key, iv string
+}
+
+func (ck *cipherKey) decrypt(payload string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("decode base64 failed: %w", err)
+	}
+
+	block, err := aes.NewCipher([]byte(ck.key))
+	if err != nil {
+		return "", fmt.Errorf("invalid key: %w", err)
+	}
+	if len(ck.iv) != block.BlockSize() {
+		return "", fmt.Errorf("invalid iv length: %d", len(ck.iv))
+	}
+	if len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return "", fmt.Errorf("invalid cipher text length: %d", len(data))
+	}
+
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, []byte(ck.iv)).CryptBlocks(plain, data)
+
+	// PKCS#7 padding
+	pad := int(plain[len(plain)-1])
+	if pad == 0 || pad > block.BlockSize() || pad > len(plain) {
+		return "", fmt.Errorf("invalid padding")
+	}
+	for _, b := range plain[len(plain)-pad:] {
+		if int(b) != pad {
+			return "", fmt.Errorf("invalid padding")
+		}
+	}
+
+	return string(plain[:len(plain)-pad]), nil
+}
@@ -0,0 +1,552 @@
+// Package realtime provides the KIS websocket client for the realtime market data
+// and the own order fill notices.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	kinvest "github.com/suapapa/go_kinvest"
+)
+
+const (
+	prodAddr = "ws://ops.koreainvestment.com:21000"
+	vtsAddr  = "ws://ops.koreainvestment.com:31000"
+)
+
+// TR IDs of the realtime feeds.
+const (
+	TrIDExecution     = "H0STCNT0" // 국내주식 실시간체결가
+	TrIDOrderBook     = "H0STASP0" // 국내주식 실시간호가
+	TrIDFillNotice    = "H0STCNI0" // 국내주식 실시간체결통보
+	TrIDFillNoticeVTS = "H0STCNI9" // 국내주식 실시간체결통보 (모의투자)
+)
+
+// Config holds the configuration for the realtime client.
+type Config struct {
+	// ApprovalKey returns the websocket approval key.
+	// Use (*kinvest.Client).GetApprovalKey for it.
+	ApprovalKey func(ctx context.Context) (string, error)
+
+	Environment kinvest.Environment // 실전투자(prod) 또는 모의투자(vts), 기본값 prod
+	URL         string              // websocket 주소, 설정하지 않으면 Environment 에 따라 결정
+	CustType    string              // 고객타입 P: 개인, B: 법인, 기본값 P
+
+	ReconnectInterval time.Duration // 재접속 대기시간, 기본값 3초
+	BufferSize        int           // 채널 버퍼 크기, 기본값 100
+}
+
+// NewConfig creates a new Config which gets the approval key from the kinvest client.
+func NewConfig(kc *kinvest.Client) *Config {
+	return &Config{
+		ApprovalKey: kc.GetApprovalKey,
+		Environment: kc.Environment(),
+		CustType:    string(kc.CustType()),
+	}
+}
+
+// Client is the websocket client for the KIS realtime feeds.
+// The received frames are decoded and delivered to the channels of each feed.
+// Drain the channels of the subscribed feeds, or the receiving will be blocked.
+type Client struct {
+	cfg Config
+
+	executions  chan *Execution
+	orderBooks  chan *OrderBook
+	fillNotices chan *FillNotice
+	errs        chan error
+
+	mu          sync.Mutex
+	conn        *websocket.Conn
+	approvalKey string
+	subs        map[subscription]struct{}
+	cipherKeys  map[string]*cipherKey
+
+	writeMu sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type subscription struct {
+	trID, trKey string
+}
+
+// NewClient creates a new realtime client.
+func NewClient(cfg *Config) (*Client, error) {
+	if cfg == nil || cfg.ApprovalKey == nil {
+		return nil, fmt.Errorf("invalid config: ApprovalKey must be set")
+	}
+
+	c := &Client{
+		cfg:        *cfg,
+		subs:       make(map[subscription]struct{}),
+		cipherKeys: make(map[string]*cipherKey),
+	}
+	if c.cfg.URL == "" {
+		c.cfg.URL = prodAddr
+		if c.cfg.Environment == kinvest.EnvironmentVTS {
+			c.cfg.URL = vtsAddr
+		}
+	}
+	if c.cfg.CustType == "" {
+		c.cfg.CustType = "P"
+	}
+	if c.cfg.ReconnectInterval <= 0 {
+		c.cfg.ReconnectInterval = 3 * time.Second
+	}
+	if c.cfg.BufferSize <= 0 {
+		c.cfg.BufferSize = 100
+	}
+
+	c.executions = make(chan *Execution, c.cfg.BufferSize)
+	c.orderBooks = make(chan *OrderBook, c.cfg.BufferSize)
+	c.fillNotices = make(chan *FillNotice, c.cfg.BufferSize)
+	c.errs = make(chan error, c.cfg.BufferSize)
+
+	return c, nil
+}
+
+// Executions returns the channel of the realtime executions (H0STCNT0).
+func (c *Client) Executions() <-chan *Execution {
+	return c.executions
+}
+
+// OrderBooks returns the channel of the realtime order books (H0STASP0).
+func (c *Client) OrderBooks() <-chan *OrderBook {
+	return c.orderBooks
+}
+
+// FillNotices returns the channel of the own order fill notices (H0STCNI0).
+func (c *Client) FillNotices() <-chan *FillNotice {
+	return c.fillNotices
+}
+
+// Errors returns the channel of the errors occurred while receiving.
+// Errors are dropped if the channel is full.
+func (c *Client) Errors() <-chan error {
+	return c.errs
+}
+
+// Start connects to the server and starts receiving in background.
+// After the connection drops, it reconnects and resubscribes the feeds until Close is called.
+// If the first connection fails, Start can be called again.
+func (c *Client) Start(ctx context.Context) error {
+	c.mu.Lock()
+	if c.done != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("already started")
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.done = make(chan struct{})
+	c.mu.Unlock()
+
+	conn, err := c.connect(ctx)
+	if err != nil {
+		// 다시 Start 할 수 있도록 되돌린다
+		c.mu.Lock()
+		c.cancel()
+		c.ctx, c.cancel, c.done = nil, nil, nil
+		c.mu.Unlock()
+		return fmt.Errorf("connect failed: %w", err)
+	}
+
+	go c.run(conn)
+	return nil
+}
+
+// Close stops receiving and closes the connection and the channels.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	cancel, done, conn := c.cancel, c.done, c.conn
+	if cancel != nil {
+		cancel()
+	}
+	c.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+
+	var err error
+	if conn != nil {
+		err = conn.Close()
+	}
+	<-done
+
+	return err
+}
+
+// SubscribeExecutions subscribes the realtime executions of the stock.
+func (c *Client) SubscribeExecutions(ctx context.Context, code string) error {
+	return c.Subscribe(ctx, TrIDExecution, code)
+}
+
+// SubscribeOrderBook subscribes the realtime order book of the stock.
+func (c *Client) SubscribeOrderBook(ctx context.Context, code string) error {
+	return c.Subscribe(ctx, TrIDOrderBook, code)
+}
+
+// SubscribeFillNotices subscribes the fill notices of own orders.
+// htsID is the HTS ID of the account owner.
+func (c *Client) SubscribeFillNotices(ctx context.Context, htsID string) error {
+	return c.Subscribe(ctx, c.fillNoticeTrID(), htsID)
+}
+
+// UnsubscribeExecutions unsubscribes the realtime executions of the stock.
+func (c *Client) UnsubscribeExecutions(ctx context.Context, code string) error {
+	return c.Unsubscribe(ctx, TrIDExecution, code)
+}
+
+// UnsubscribeOrderBook unsubscribes the realtime order book of the stock.
+func (c *Client) UnsubscribeOrderBook(ctx context.Context, code string) error {
+	return c.Unsubscribe(ctx, TrIDOrderBook, code)
+}
+
+// UnsubscribeFillNotices unsubscribes the fill notices of own orders.
+func (c *Client) UnsubscribeFillNotices(ctx context.Context, htsID string) error {
+	return c.Unsubscribe(ctx, c.fillNoticeTrID(), htsID)
+}
+
+// Subscribe subscribes the feed of trID with trKey.
+// The subscription is kept and sent again after reconnecting.
+// If the client is not started yet, it will be sent on Start.
+// The deadline of ctx is used as the write deadline of the request.
+func (c *Client) Subscribe(ctx context.Context, trID, trKey string) error {
+	sub := subscription{trID: trID, trKey: trKey}
+
+	c.mu.Lock()
+	c.subs[sub] = struct{}{}
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return c.send(ctx, conn, sub, "1")
+}
+
+// Unsubscribe unsubscribes the feed of trID with trKey.
+// The deadline of ctx is used as the write deadline of the request.
+func (c *Client) Unsubscribe(ctx context.Context, trID, trKey string) error {
+	sub := subscription{trID: trID, trKey: trKey}
+
+	c.mu.Lock()
+	delete(c.subs, sub)
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return c.send(ctx, conn, sub, "2")
+}
+
+func (c *Client) fillNoticeTrID() string {
+	if c.cfg.Environment == kinvest.EnvironmentVTS {
+		return TrIDFillNoticeVTS
+	}
+	return TrIDFillNotice
+}
+
+func (c *Client) connect(ctx context.Context) (*websocket.Conn, error) {
+	approvalKey, err := c.cfg.ApprovalKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get approval key failed: %w", err)
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.cfg.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("dial failed: %w", err)
+	}
+
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		conn.Close()
+		return nil, c.ctx.Err()
+	}
+	c.conn = conn
+	c.approvalKey = approvalKey
+	subs := make([]subscription, 0, len(c.subs))
+	for sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		if err := c.send(ctx, conn, sub, "1"); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("resubscribe %s(%s) failed: %w", sub.trID, sub.trKey, err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (c *Client) run(conn *websocket.Conn) {
+	defer func() {
+		close(c.executions)
+		close(c.orderBooks)
+		close(c.fillNotices)
+		close(c.errs)
+		close(c.done)
+	}()
+
+	for {
+		err := c.receive(conn)
+		conn.Close()
+		if c.ctx.Err() != nil {
+			return
+		}
+		c.reportErr(fmt.Errorf("connection lost: %w", err))
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-time.After(c.cfg.ReconnectInterval):
+			}
+
+			conn, err = c.connect(c.ctx)
+			if err == nil {
+				break
+			}
+			if c.ctx.Err() != nil {
+				return
+			}
+			c.reportErr(fmt.Errorf("reconnect failed: %w", err))
+		}
+	}
+}
+
+func (c *Client) receive(conn *websocket.Conn) error {
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		if len(msg) == 0 {
+			continue
+		}
+
+		switch msg[0] {
+		case '0', '1':
+			err = c.handleData(string(msg))
+		default:
+			err = c.handleControl(conn, msg)
+		}
+		if err != nil {
+			c.reportErr(err)
+		}
+	}
+}
+
+type request struct {
+	Header requestHeader `json:"header"`
+	Body   requestBody   `json:"body"`
+}
+
+type requestHeader struct {
+	ApprovalKey string `json:"approval_key"`
+	CustType    string `json:"custtype"`
+	TrType      string `json:"tr_type"` // 1: 등록, 2: 해제
+	ContentType string `json:"content-type"`
+}
+
+type requestBody struct {
+	Input requestInput `json:"input"`
+}
+
+type requestInput struct {
+	TrID  string `json:"tr_id"`
+	TrKey string `json:"tr_key"`
+}
+
+func (c *Client) send(ctx context.Context, conn *websocket.Conn, sub subscription, trType string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	approvalKey := c.approvalKey
+	c.mu.Unlock()
+
+	req := &request{
+		Header: requestHeader{
+			ApprovalKey: approvalKey,
+			CustType:    c.cfg.CustType,
+			TrType:      trType,
+			ContentType: "utf-8",
+		},
+		Body: requestBody{
+			Input: requestInput{
+				TrID:  sub.trID,
+				TrKey: sub.trKey,
+			},
+		},
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetWriteDeadline(deadline)
+		defer conn.SetWriteDeadline(time.Time{})
+	}
+	if err := conn.WriteJSON(req); err != nil {
+		return fmt.Errorf("write request failed: %w", err)
+	}
+	return nil
+}
+
+type response struct {
+	Header struct {
+		TrID    string `json:"tr_id"`
+		TrKey   string `json:"tr_key"`
+		Encrypt string `json:"encrypt"`
+	} `json:"header"`
+	Body struct {
+		RtCd   string `json:"rt_cd"`
+		MsgCd  string `json:"msg_cd"`
+		Msg1   string `json:"msg1"`
+		Output struct {
+			IV  string `json:"iv"`
+			Key string `json:"key"`
+		} `json:"output"`
+	} `json:"body"`
+}
+
+func (c *Client) handleControl(conn *websocket.Conn, msg []byte) error {
+	resp := &response{}
+	if err := json.Unmarshal(msg, resp); err != nil {
+		return fmt.Errorf("unmarshal control message failed: %w", err)
+	}
+
+	// KIS 서버의 연결 확인 메시지는 그대로 돌려준다
+	if resp.Header.TrID == "PINGPONG" {
+		c.writeMu.Lock()
+		defer c.writeMu.Unlock()
+		if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+			return fmt.Errorf("write pong failed: %w", err)
+		}
+		return nil
+	}
+
+	if resp.Body.RtCd != "0" {
+		// kinvest.IsTokenExpired 등으로 판별할 수 있게 APIError 로 준다
+		return fmt.Errorf("control message of %s: %w", resp.Header.TrKey, &kinvest.APIError{
+			TrID:  resp.Header.TrID,
+			RtCd:  resp.Body.RtCd,
+			MsgCd: resp.Body.MsgCd,
+			Msg1:  strings.TrimSpace(resp.Body.Msg1),
+		})
+	}
+
+	if resp.Body.Output.Key != "" && resp.Body.Output.IV != "" {
+		c.mu.Lock()
+		c.cipherKeys[resp.Header.TrID] = &cipherKey{
+			key: resp.Body.Output.Key,
+			iv:  resp.Body.Output.IV,
+		}
+		c.mu.Unlock()
+	}
+
+	return nil
+}
+
+// handleData decodes the data frame, which looks like
+//
+//	0|H0STCNT0|001|005930^093354^71900^5^-100^...
+//
+// The first field tells the payload is encrypted(1) or not(0),
+// and the third one is the count of the records in the payload.
+func (c *Client) handleData(msg string) error {
+	parts := strings.SplitN(msg, "|", 4)
+	if len(parts) != 4 {
+		return fmt.Errorf("invalid data frame: %s", msg)
+	}
+	encrypted, trID, payload := parts[0] == "1", parts[1], parts[3]
+	cnt, err := strconv.Atoi(parts[2])
+	if err != nil || cnt <= 0 {
+		return fmt.Errorf("invalid record count of %s: %s", trID, parts[2])
+	}
+
+	if encrypted {
+		c.mu.Lock()
+		ck := c.cipherKeys[trID]
+		c.mu.Unlock()
+		if ck == nil {
+			return fmt.Errorf("no cipher key for %s", trID)
+		}
+		payload, err = ck.decrypt(payload)
+		if err != nil {
+			return fmt.Errorf("decrypt %s failed: %w", trID, err)
+		}
+	}
+
+	fields := strings.Split(payload, "^")
+	if len(fields)%cnt != 0 {
+		return fmt.Errorf("invalid field count of %s: %d fields for %d records", trID, len(fields), cnt)
+	}
+	n := len(fields) / cnt
+
+	for i := range cnt {
+		record := fields[i*n : (i+1)*n]
+		switch trID {
+		case TrIDExecution:
+			e, err := parseExecution(record)
+			if err != nil {
+				return err
+			}
+			if !deliver(c.ctx, c.executions, e) {
+				return nil
+			}
+		case TrIDOrderBook:
+			ob, err := parseOrderBook(record)
+			if err != nil {
+				return err
+			}
+			if !deliver(c.ctx, c.orderBooks, ob) {
+				return nil
+			}
+		case TrIDFillNotice, TrIDFillNoticeVTS:
+			fn, err := parseFillNotice(record)
+			if err != nil {
+				return err
+			}
+			if !deliver(c.ctx, c.fillNotices, fn) {
+				return nil
+			}
+		default:
+			return fmt.Errorf("unsupported tr id: %s", trID)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) reportErr(err error) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	select {
+	case c.errs <- err:
+	default:
+	}
+}
+
+func deliver[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
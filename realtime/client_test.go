@@ -0,0 +1,227 @@
+package realtime
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	kinvest "github.com/suapapa/go_kinvest"
+)
+
+const (
+	testKey = "0123456789abcdef0123456789abcdef"
+	testIV  = "abcdef0123456789"
+)
+
+func encrypt(t *testing.T, plain string) string {
+	block, err := aes.NewCipher([]byte(testKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pad := block.BlockSize() - len(plain)%block.BlockSize()
+	data := []byte(plain + strings.Repeat(string(rune(pad)), pad))
+	cipher.NewCBCEncrypter(block, []byte(testIV)).CryptBlocks(data, data)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func executionFields(code, hms, price string) []string {
+	f := make([]string, executionFieldCnt)
+	f[0], f[1], f[2], f[3], f[4], f[5] = code, hms, price, "2", "100", "0.14"
+	f[13], f[18], f[33], f[35] = "1234567", "105.30", "20250102", "N"
+	return f
+}
+
+func TestClient(t *testing.T) {
+	fillNotice := make([]string, 26)
+	fillNotice[1], fillNotice[2], fillNotice[4] = "12345678", "0000012345", "02"
+	fillNotice[8], fillNotice[9], fillNotice[10], fillNotice[11] = "005930", "10", "71900", "093001"
+	fillNotice[12], fillNotice[13], fillNotice[16] = "0", "2", "10"
+	fillNotice[24], fillNotice[25] = "삼성전자", "72000"
+
+	subsCh := make(chan []string, 10)
+	var conns atomic.Int32
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		n := conns.Add(1)
+
+		var subs []string
+		for range 2 {
+			req := &request{}
+			if err := conn.ReadJSON(req); err != nil {
+				return
+			}
+			assert.Equal(t, "test-approval-key", req.Header.ApprovalKey)
+			assert.Equal(t, "1", req.Header.TrType)
+			in := req.Body.Input
+			subs = append(subs, in.TrID+":"+in.TrKey)
+
+			resp := fmt.Sprintf(`{"header":{"tr_id":"%s","tr_key":"%s","encrypt":"N"},"body":{"rt_cd":"0","msg_cd":"OPSP0000","msg1":"SUBSCRIBE SUCCESS","output":{"iv":"%s","key":"%s"}}}`,
+				in.TrID, in.TrKey, testIV, testKey)
+			conn.WriteMessage(websocket.TextMessage, []byte(resp))
+		}
+		subsCh <- subs
+
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"header":{"tr_id":"PINGPONG","datetime":"20250102093000"}}`))
+		_, pong, err := conn.ReadMessage()
+		assert.NoError(t, err)
+		assert.Contains(t, string(pong), "PINGPONG")
+
+		// 두 건의 체결을 한 프레임에
+		exec := append(executionFields("005930", "093000", "71900"), executionFields("005930", "093001", "72000")...)
+		conn.WriteMessage(websocket.TextMessage, []byte("0|H0STCNT0|002|"+strings.Join(exec, "^")))
+		conn.WriteMessage(websocket.TextMessage, []byte("1|H0STCNI0|001|"+encrypt(t, strings.Join(fillNotice, "^"))))
+
+		if n > 1 {
+			conn.ReadMessage() // wait for client close
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(&Config{
+		URL: "ws" + strings.TrimPrefix(srv.URL, "http"),
+		ApprovalKey: func(ctx context.Context) (string, error) {
+			return "test-approval-key", nil
+		},
+		ReconnectInterval: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.NoError(t, c.SubscribeExecutions(ctx, "005930"))
+	assert.NoError(t, c.SubscribeFillNotices(ctx, "htsid"))
+	assert.NoError(t, c.Start(ctx))
+
+	// 재접속 후에도 같은 구독이 다시 요청되어야 한다
+	for range 2 {
+		select {
+		case subs := <-subsCh:
+			assert.ElementsMatch(t, []string{"H0STCNT0:005930", "H0STCNI0:htsid"}, subs)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting subscriptions")
+		}
+
+		for _, price := range []int{71900, 72000} {
+			e := <-c.Executions()
+			assert.Equal(t, "005930", e.Code)
+			assert.Equal(t, price, e.Price)
+			assert.Equal(t, 1234567, e.AccVolume)
+			assert.Equal(t, 105.30, e.Strength)
+			assert.Equal(t, 2025, e.Time.Year())
+		}
+
+		fn := <-c.FillNotices()
+		assert.Equal(t, "0000012345", fn.OrderNo)
+		assert.Equal(t, "매수", fn.Side)
+		assert.True(t, fn.Filled)
+		assert.False(t, fn.Rejected)
+		assert.Equal(t, 10, fn.Qty)
+		assert.Equal(t, 71900, fn.Price)
+		assert.Equal(t, "삼성전자", fn.Name)
+		assert.Equal(t, 72000, fn.OrderPrice)
+	}
+
+	assert.NoError(t, c.Close())
+	_, ok := <-c.Executions()
+	assert.False(t, ok)
+}
+
+func TestCipherKeyDecrypt(t *testing.T) {
+	ck := &cipherKey{key: testKey, iv: testIV}
+
+	plain, err := ck.decrypt(encrypt(t, "hello^world"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello^world", plain)
+
+	_, err = ck.decrypt("not-base64!")
+	assert.Error(t, err)
+
+	_, err = ck.decrypt(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+}
+
+func TestRequestJSON(t *testing.T) {
+	req := &request{
+		Header: requestHeader{ApprovalKey: "key", CustType: "P", TrType: "1", ContentType: "utf-8"},
+		Body:   requestBody{Input: requestInput{TrID: TrIDOrderBook, TrKey: "005930"}},
+	}
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"header":{"approval_key":"key","custtype":"P","tr_type":"1","content-type":"utf-8"},"body":{"input":{"tr_id":"H0STASP0","tr_key":"005930"}}}`,
+		string(b))
+}
+
+func TestHandleControlError(t *testing.T) {
+	c := &Client{}
+	err := c.handleControl(nil, []byte(`{"header":{"tr_id":"H0STCNT0","tr_key":"005930"},"body":{"rt_cd":"1","msg_cd":"EGW00123","msg1":"기간이 만료된 token 입니다. "}}`))
+
+	var apiErr *kinvest.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, "H0STCNT0", apiErr.TrID)
+		assert.Equal(t, "기간이 만료된 token 입니다.", apiErr.Msg1)
+	}
+	assert.True(t, kinvest.IsTokenExpired(err))
+}
+
+func TestClientStartAgain(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	var calls atomic.Int32
+	c, err := NewClient(&Config{
+		URL: "ws" + strings.TrimPrefix(srv.URL, "http"),
+		ApprovalKey: func(ctx context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				return "", fmt.Errorf("approval key not issued")
+			}
+			return "test-approval-key", nil
+		},
+	})
+	assert.NoError(t, err)
+
+	// 처음 접속에 실패해도 다시 시작할 수 있다
+	ctx := context.Background()
+	assert.Error(t, c.Start(ctx))
+	if !assert.NoError(t, c.Start(ctx)) {
+		return
+	}
+	assert.Error(t, c.Start(ctx))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, c.SubscribeExecutions(canceled, "005930"), context.Canceled)
+
+	timeout, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	assert.NoError(t, c.SubscribeExecutions(timeout, "005930"))
+
+	assert.NoError(t, c.Close())
+}
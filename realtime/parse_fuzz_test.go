@@ -0,0 +1,59 @@
+package realtime
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// FuzzHandleData feeds the data frames to the client, which should never panic.
+func FuzzHandleData(f *testing.F) {
+	fillNotice := make([]string, 26)
+	fillNotice[4], fillNotice[11], fillNotice[24] = "02", "093001", "삼성전자"
+	for _, seed := range []string{
+		"0|H0STCNT0|001|" + strings.Join(executionFields("005930", "093000", "71900"), "^"),
+		"0|H0STCNT0|002|" + strings.Join(append(executionFields("005930", "093000", "71900"), executionFields("005930", "093001", "72000")...), "^"),
+		"0|H0STASP0|001|005930^093000^72000",
+		"0|H0STCNI0|001|" + strings.Join(fillNotice, "^"),
+		"0|H0STCNT0|0|",
+		"0|H0STCNT0|003|a^b",
+		"1|H0STCNI0|001|not-base64",
+		"1|H0STCNI0|001|AAAAAAAAAAAAAAAAAAAAAA==",
+		"0|UNKNOWN|001|x",
+		"|||",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, msg string) {
+		c, err := NewClient(&Config{
+			ApprovalKey: func(ctx context.Context) (string, error) { return "", nil },
+			BufferSize:  1000,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 채널이 차도 막히지 않도록 취소된 컨텍스트를 쓴다
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c.ctx = ctx
+		c.cipherKeys[TrIDFillNotice] = &cipherKey{key: testKey, iv: testIV}
+
+		c.handleData(msg)
+	})
+}
+
+// FuzzParseRecords parses the fields of a record, which should never panic.
+func FuzzParseRecords(f *testing.F) {
+	f.Add(strings.Join(executionFields("005930", "093000", "71900"), "^"))
+	f.Add(strings.Repeat("1^", orderBookFieldCnt))
+	f.Add(strings.Repeat("^", fillNoticeFieldCnt))
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, payload string) {
+		fields := strings.Split(payload, "^")
+		parseExecution(fields)
+		parseOrderBook(fields)
+		parseFillNotice(fields)
+	})
+}
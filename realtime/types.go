@@ -0,0 +1,178 @@
+package realtime
+
+import (
+	"fmt"
+	"time"
+
+	kinvest "github.com/suapapa/go_kinvest"
+	"github.com/suapapa/go_kinvest/internal/conv"
+)
+
+// Execution represents a realtime execution of a stock (H0STCNT0).
+type Execution struct {
+	Code          string             `yaml:"종목코드"`
+	Time          time.Time          `yaml:"체결시간"`
+	Price         int                `yaml:"현재가"`
+	ChangeSign    kinvest.ChangeSign `yaml:"전일대비부호"`
+	Change        int                `yaml:"전일대비"`
+	ChangeRate    float64            `yaml:"전일대비율"`
+	WeightedAvg   float64            `yaml:"가중평균주식가격"`
+	Open          int                `yaml:"시가"`
+	High          int                `yaml:"고가"`
+	Low           int                `yaml:"저가"`
+	Ask1          int                `yaml:"매도호가1"`
+	Bid1          int                `yaml:"매수호가1"`
+	Volume        int                `yaml:"체결거래량"`
+	AccVolume     int                `yaml:"누적거래량"`
+	AccValue      int                `yaml:"누적거래대금"`
+	SellCount     int                `yaml:"매도체결건수"`
+	BuyCount      int                `yaml:"매수체결건수"`
+	Strength      float64            `yaml:"체결강도"`
+	TotalSellQty  int                `yaml:"총매도수량"`
+	TotalBuyQty   int                `yaml:"총매수수량"`
+	Side          string             `yaml:"체결구분"` // 1: 매수, 3: 장전, 5: 매도
+	AskQty1       int                `yaml:"매도호가잔량1"`
+	BidQty1       int                `yaml:"매수호가잔량1"`
+	TotalAskQty   int                `yaml:"총매도호가잔량"`
+	TotalBidQty   int                `yaml:"총매수호가잔량"`
+	TradingHalted bool               `yaml:"거래정지여부"`
+}
+
+const executionFieldCnt = 46
+
+func parseExecution(f []string) (*Execution, error) {
+	if len(f) < executionFieldCnt {
+		return nil, fmt.Errorf("invalid execution: %d fields", len(f))
+	}
+
+	t, err := conv.YmdHmsToTime(f[33], f[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid execution time: %w", err)
+	}
+
+	return &Execution{
+		Code:          f[0],
+		Time:          t,
+		Price:         conv.ToInt(f[2]),
+		ChangeSign:    kinvest.ChangeSign(f[3]),
+		Change:        conv.ToInt(f[4]),
+		ChangeRate:    conv.ToFloat(f[5]),
+		WeightedAvg:   conv.ToFloat(f[6]),
+		Open:          conv.ToInt(f[7]),
+		High:          conv.ToInt(f[8]),
+		Low:           conv.ToInt(f[9]),
+		Ask1:          conv.ToInt(f[10]),
+		Bid1:          conv.ToInt(f[11]),
+		Volume:        conv.ToInt(f[12]),
+		AccVolume:     conv.ToInt(f[13]),
+		AccValue:      conv.ToInt(f[14]),
+		SellCount:     conv.ToInt(f[15]),
+		BuyCount:      conv.ToInt(f[16]),
+		Strength:      conv.ToFloat(f[18]),
+		TotalSellQty:  conv.ToInt(f[19]),
+		TotalBuyQty:   conv.ToInt(f[20]),
+		Side:          f[21],
+		TradingHalted: f[35] == "Y",
+		AskQty1:       conv.ToInt(f[36]),
+		BidQty1:       conv.ToInt(f[37]),
+		TotalAskQty:   conv.ToInt(f[38]),
+		TotalBidQty:   conv.ToInt(f[39]),
+	}, nil
+}
+
+// OrderBookLevel is a price level of the order book.
+type OrderBookLevel struct {
+	Price int `yaml:"호가"`
+	Qty   int `yaml:"잔량"`
+}
+
+// OrderBook represents a realtime order book of a stock (H0STASP0).
+// Asks and Bids are ordered from the best price.
+type OrderBook struct {
+	Code          string             `yaml:"종목코드"`
+	Time          time.Time          `yaml:"영업시간"`
+	Asks          [10]OrderBookLevel `yaml:"매도호가"`
+	Bids          [10]OrderBookLevel `yaml:"매수호가"`
+	TotalAskQty   int                `yaml:"총매도호가잔량"`
+	TotalBidQty   int                `yaml:"총매수호가잔량"`
+	ExpectedPrice int                `yaml:"예상체결가,omitempty"`
+	ExpectedQty   int                `yaml:"예상체결량,omitempty"`
+	AccVolume     int                `yaml:"누적거래량"`
+}
+
+const orderBookFieldCnt = 59
+
+func parseOrderBook(f []string) (*OrderBook, error) {
+	if len(f) < orderBookFieldCnt {
+		return nil, fmt.Errorf("invalid order book: %d fields", len(f))
+	}
+
+	t, err := conv.HHMMSSToTime(f[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid order book time: %w", err)
+	}
+
+	ob := &OrderBook{
+		Code:          f[0],
+		Time:          t,
+		TotalAskQty:   conv.ToInt(f[43]),
+		TotalBidQty:   conv.ToInt(f[44]),
+		ExpectedPrice: conv.ToInt(f[47]),
+		ExpectedQty:   conv.ToInt(f[48]),
+		AccVolume:     conv.ToInt(f[53]),
+	}
+	for i := range 10 {
+		ob.Asks[i] = OrderBookLevel{Price: conv.ToInt(f[3+i]), Qty: conv.ToInt(f[23+i])}
+		ob.Bids[i] = OrderBookLevel{Price: conv.ToInt(f[13+i]), Qty: conv.ToInt(f[33+i])}
+	}
+
+	return ob, nil
+}
+
+// FillNotice represents a notice of own order (H0STCNI0).
+// It is sent on order accepted, modified, canceled, rejected and filled.
+type FillNotice struct {
+	Account     string    `yaml:"계좌번호"`
+	OrderNo     string    `yaml:"주문번호"`
+	OrigOrderNo string    `yaml:"원주문번호,omitempty"`
+	Side        string    `yaml:"매도매수구분"` // 매도, 매수
+	Filled      bool      `yaml:"체결여부"`   // false 면 주문/정정/취소/거부 접수 통보
+	Rejected    bool      `yaml:"거부여부"`
+	Code        string    `yaml:"종목코드"`
+	Name        string    `yaml:"종목명"`
+	Qty         int       `yaml:"체결수량"` // 체결이 아닌 경우 주문수량
+	Price       int       `yaml:"체결단가"` // 체결이 아닌 경우 주문가격
+	Time        time.Time `yaml:"체결시간"`
+	OrderQty    int       `yaml:"주문수량"`
+	OrderPrice  int       `yaml:"주문가격"`
+}
+
+// 종목명, 주문가격은 항상 마지막 두 필드
+const fillNoticeFieldCnt = 23
+
+func parseFillNotice(f []string) (*FillNotice, error) {
+	if len(f) < fillNoticeFieldCnt {
+		return nil, fmt.Errorf("invalid fill notice: %d fields", len(f))
+	}
+
+	t, err := conv.HHMMSSToTime(f[11])
+	if err != nil {
+		return nil, fmt.Errorf("invalid fill notice time: %w", err)
+	}
+
+	return &FillNotice{
+		Account:     f[1],
+		OrderNo:     f[2],
+		OrigOrderNo: f[3],
+		Side:        conv.SideNames[f[4]],
+		Code:        f[8],
+		Qty:         conv.ToInt(f[9]),
+		Price:       conv.ToInt(f[10]),
+		Time:        t,
+		Rejected:    f[12] == "1",
+		Filled:      f[13] == "2",
+		OrderQty:    conv.ToInt(f[16]),
+		Name:        f[len(f)-2],
+		OrderPrice:  conv.ToInt(f[len(f)-1]),
+	}, nil
+}
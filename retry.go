@@ -0,0 +1,112 @@
+package kinvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// RetryPolicy is the policy to retry the failed requests.
+// Only GET requests are retried. Orders (POST) are never retried,
+// as the retry may place the same order twice.
+type RetryPolicy struct {
+	MaxAttempts int           // 최대 시도 횟수, 1 이면 재시도하지 않음
+	BaseDelay   time.Duration // 첫 재시도 대기시간, 이후 두 배씩 늘어남
+	MaxDelay    time.Duration // 최대 대기시간
+}
+
+// DefaultRetryPolicy is used if ClientConfig.Retry is nil.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// backoff returns the delay before the n-th retry, with the jitter.
+func (p *RetryPolicy) backoff(n int) time.Duration {
+	d := p.BaseDelay << (n - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	// 여러 요청이 동시에 재시도하지 않도록 [d/2, d) 사이에서 고른다
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryDoer retries GET requests on network errors, 5xx responses and the rate limit errors.
+type retryDoer struct {
+	doer   oapi.HttpRequestDoer
+	policy RetryPolicy
+}
+
+func newRetryDoer(doer oapi.HttpRequestDoer, policy *RetryPolicy) oapi.HttpRequestDoer {
+	if policy == nil {
+		policy = &DefaultRetryPolicy
+	}
+	if policy.MaxAttempts <= 1 {
+		return doer
+	}
+	return &retryDoer{
+		doer:   doer,
+		policy: *policy,
+	}
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return d.doer.Do(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := d.doer.Do(req)
+		if attempt >= d.policy.MaxAttempts || !shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(d.policy.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// 초당 거래건수 초과는 200 으로 응답하기도 하므로 body 를 확인한다
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	var data struct {
+		MsgCd string `json:"msg_cd"`
+	}
+	if json.Unmarshal(body, &data) != nil {
+		return false
+	}
+	return data.MsgCd == msgCdRateLimited
+}
@@ -0,0 +1,59 @@
+package kinvest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDoer(t *testing.T) {
+	var cnt atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch cnt.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusInternalServerError)
+		case 2:
+			w.Write([]byte(`{"rt_cd":"1","msg_cd":"EGW00201","msg1":"초당 거래건수를 초과하였습니다."}`))
+		default:
+			w.Write([]byte(`{"rt_cd":"0","msg_cd":"MCA00000","msg1":"정상처리 되었습니다."}`))
+		}
+	}))
+	defer srv.Close()
+
+	doer := newRetryDoer(http.DefaultClient, &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := doer.Do(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), `"rt_cd":"0"`)
+	assert.Equal(t, int32(3), cnt.Load())
+
+	// 주문은 재시도하지 않는다
+	cnt.Store(0)
+	req, _ = http.NewRequest(http.MethodPost, srv.URL, nil)
+	resp, err = doer.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(1), cnt.Load())
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for n := 1; n <= 10; n++ {
+		d := p.backoff(n)
+		assert.LessOrEqual(t, d, time.Second)
+		assert.GreaterOrEqual(t, d, min(p.BaseDelay<<(n-1), p.MaxDelay)/2)
+	}
+}
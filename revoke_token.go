@@ -0,0 +1,56 @@
+package kinvest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// RevokeToken revokes the access token (접근토큰폐기) and deletes it from the token store.
+// Without a token in memory, it revokes the one in the token store.
+// It does nothing if neither has a token, and skips the request for an expired one.
+// The next API call will issue a new token.
+// The other processes sharing the token store lose the token too.
+func (c *Client) RevokeToken(ctx context.Context) error {
+	t := c.currentToken()
+	if t == nil {
+		var err error
+		t, err = c.tokenStore.Load(ctx)
+		if errors.Is(err, ErrTokenNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load token: %w", err)
+		}
+	}
+
+	if !t.IsExpired() {
+		resp, err := c.oc.PostOauth2RevokeP(
+			ctx,
+			&oapi.PostOauth2RevokePParams{},
+			oapi.PostOauth2RevokePJSONRequestBody{
+				"appkey":    c.appKey,
+				"appsecret": c.appSecret,
+				"token":     t.AccessToken,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return errorFromResponse(resp)
+		}
+	}
+
+	c.setToken(nil)
+	if err := c.tokenStore.Delete(ctx); err != nil {
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package kinvest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientClose(t *testing.T) {
+	var revoked string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth2/revokeP", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		revoked = body["token"]
+		w.Write([]byte(`{"code":200,"message":"접근토큰 폐기에 성공하였습니다"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	store := NewMemoryTokenStore()
+	assert.NoError(t, store.Save(ctx, &Token{TokenType: "Bearer", AccessToken: "stored-token", ExpiresIn: time.Now().Add(time.Hour)}))
+
+	c := newTestClient(t, srv)
+	c.tokenStore = store
+
+	assert.NoError(t, c.Close(ctx))
+	assert.Equal(t, "stored-token", revoked)
+	assert.Nil(t, c.token)
+	_, err := store.Load(ctx)
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+
+	// 토큰이 없으면 아무것도 하지 않는다
+	revoked = ""
+	assert.NoError(t, c.Close(ctx))
+	assert.Empty(t, revoked)
+}
+
+func TestClientCloseKeepToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	store := NewMemoryTokenStore()
+	assert.NoError(t, store.Save(ctx, &Token{TokenType: "Bearer", AccessToken: "shared-token", ExpiresIn: time.Now().Add(time.Hour)}))
+
+	c := newTestClient(t, srv)
+	c.tokenStore = store
+	c.keepToken = true
+
+	// 다른 프로세스와 같이 쓰는 토큰은 폐기하지 않는다
+	assert.NoError(t, c.Close(ctx))
+	tok, err := store.Load(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "shared-token", tok.AccessToken)
+	}
+}
@@ -0,0 +1,43 @@
+package kinvest
+
+// ChangeSign is the sign code of the price change, such as 전일대비부호.
+type ChangeSign string
+
+const (
+	ChangeSignUpperLimit ChangeSign = "1" // 상한
+	ChangeSignRise       ChangeSign = "2" // 상승
+	ChangeSignFlat       ChangeSign = "3" // 보합
+	ChangeSignLowerLimit ChangeSign = "4" // 하한
+	ChangeSignFall       ChangeSign = "5" // 하락
+)
+
+var changeSignNames = map[ChangeSign]string{
+	ChangeSignUpperLimit: "상한",
+	ChangeSignRise:       "상승",
+	ChangeSignFlat:       "보합",
+	ChangeSignLowerLimit: "하한",
+	ChangeSignFall:       "하락",
+}
+
+// String returns the Korean name of the sign.
+func (s ChangeSign) String() string {
+	if name, ok := changeSignNames[s]; ok {
+		return name
+	}
+	return string(s)
+}
+
+// IsUp reports whether the price went up.
+func (s ChangeSign) IsUp() bool {
+	return s == ChangeSignUpperLimit || s == ChangeSignRise
+}
+
+// IsDown reports whether the price went down.
+func (s ChangeSign) IsDown() bool {
+	return s == ChangeSignLowerLimit || s == ChangeSignFall
+}
+
+// MarshalYAML marshals the sign in its Korean name.
+func (s ChangeSign) MarshalYAML() (any, error) {
+	return s.String(), nil
+}
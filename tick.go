@@ -0,0 +1,58 @@
+package kinvest
+
+// RoundDirection is the direction to round the price to the tick.
+type RoundDirection int
+
+const (
+	RoundDown    RoundDirection = iota // 내림
+	RoundUp                            // 올림
+	RoundNearest                       // 가까운 호가, 가운데면 올림
+)
+
+// TickSize returns the KRX tick size (호가단위) of the stock at price.
+// ETF, ETN and ELW have their own tick sizes.
+func TickSize(price int) int {
+	switch {
+	case price < 2000:
+		return 1
+	case price < 5000:
+		return 5
+	case price < 20000:
+		return 10
+	case price < 50000:
+		return 50
+	case price < 200000:
+		return 100
+	case price < 500000:
+		return 500
+	default:
+		return 1000
+	}
+}
+
+// RoundToTick rounds price to the KRX tick grid of the stock in dir.
+// The band boundaries are on both grids, so the result is always a valid price.
+func RoundToTick(price int, dir RoundDirection) int {
+	if price <= 0 {
+		return 0
+	}
+
+	tick := TickSize(price)
+	down := price - price%tick
+	if down == price {
+		return price
+	}
+	up := down + tick
+
+	switch dir {
+	case RoundUp:
+		return up
+	case RoundNearest:
+		if price-down < up-price {
+			return down
+		}
+		return up
+	default:
+		return down
+	}
+}
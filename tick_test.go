@@ -0,0 +1,31 @@
+package kinvest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundToTick(t *testing.T) {
+	tests := []struct {
+		price             int
+		down, up, nearest int
+	}{
+		{1999, 1999, 1999, 1999},
+		{2003, 2000, 2005, 2005},
+		{4998, 4995, 5000, 5000},
+		{19995, 19990, 20000, 20000},
+		{20020, 20000, 20050, 20000},
+		{71950, 71900, 72000, 72000},
+		{199950, 199900, 200000, 200000},
+		{499800, 499500, 500000, 500000},
+		{512345, 512000, 513000, 512000},
+		{0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.down, RoundToTick(tt.price, RoundDown), "down %d", tt.price)
+		assert.Equal(t, tt.up, RoundToTick(tt.price, RoundUp), "up %d", tt.price)
+		assert.Equal(t, tt.nearest, RoundToTick(tt.price, RoundNearest), "nearest %d", tt.price)
+	}
+}
@@ -0,0 +1,58 @@
+package kinvest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/suapapa/go_kinvest/internal/oapi"
+)
+
+// DefaultTimeout is used if ClientConfig.Timeout is 0.
+const DefaultTimeout = 10 * time.Second
+
+// timeoutDoer limits each request to the timeout if its context has no deadline.
+type timeoutDoer struct {
+	doer    oapi.HttpRequestDoer
+	timeout time.Duration
+}
+
+func newTimeoutDoer(doer oapi.HttpRequestDoer, timeout time.Duration) oapi.HttpRequestDoer {
+	switch {
+	case timeout < 0:
+		return doer
+	case timeout == 0:
+		timeout = DefaultTimeout
+	}
+	return &timeoutDoer{
+		doer:    doer,
+		timeout: timeout,
+	}
+}
+
+func (d *timeoutDoer) Do(req *http.Request) (*http.Response, error) {
+	if _, ok := req.Context().Deadline(); ok {
+		return d.doer.Do(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), d.timeout)
+	resp, err := d.doer.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// 본문을 다 읽기 전에 컨텍스트가 취소되지 않도록 Close 에서 취소한다
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,174 @@
+package kinvest
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// ErrTokenNotFound is returned by TokenStore.Load when no token is stored.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore persists the access token.
+// KIS limits the number of tokens issued per day, so processes sharing
+// an app key should share a TokenStore to reuse the token.
+// Client.Close and Client.RevokeToken revoke the shared token for all of them;
+// set ClientConfig.KeepTokenOnClose for such processes.
+type TokenStore interface {
+	// Load returns the stored token or ErrTokenNotFound.
+	// The returned token may be expired.
+	Load(ctx context.Context) (*Token, error)
+	// Save stores the token, replacing the old one.
+	Save(ctx context.Context, t *Token) error
+	// Delete removes the stored token. It is not an error if no token is stored.
+	Delete(ctx context.Context) error
+}
+
+// FileTokenStore stores the token in a json or yaml file.
+type FileTokenStore struct {
+	Path string
+}
+
+// NewFileTokenStore creates a new FileTokenStore.
+// The file format follows the extension of tokenPath, json or yaml.
+func NewFileTokenStore(tokenPath string) *FileTokenStore {
+	return &FileTokenStore{Path: tokenPath}
+}
+
+func (s *FileTokenStore) Load(ctx context.Context) (*Token, error) {
+	if !fileExists(s.Path) {
+		return nil, ErrTokenNotFound
+	}
+	return loadToken(s.Path)
+}
+
+func (s *FileTokenStore) Save(ctx context.Context, t *Token) error {
+	return t.Save(s.Path)
+}
+
+func (s *FileTokenStore) Delete(ctx context.Context) error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// MemoryTokenStore keeps the token in memory only.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewMemoryTokenStore creates a new MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		return nil, ErrTokenNotFound
+	}
+	t := *s.token
+	return &t, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, t *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *t
+	s.token = &saved
+	return nil
+}
+
+func (s *MemoryTokenStore) Delete(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = nil
+	return nil
+}
+
+// EncryptedFileTokenStore stores the token in a file encrypted with AES-GCM.
+type EncryptedFileTokenStore struct {
+	Path string
+	aead cipher.AEAD
+}
+
+// NewEncryptedFileTokenStore creates a new EncryptedFileTokenStore.
+// key must be 16, 24 or 32 bytes to select AES-128, AES-192 or AES-256.
+func NewEncryptedFileTokenStore(tokenPath string, key []byte) (*EncryptedFileTokenStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return &EncryptedFileTokenStore{
+		Path: tokenPath,
+		aead: aead,
+	}, nil
+}
+
+func (s *EncryptedFileTokenStore) Load(ctx context.Context) (*Token, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("invalid token file")
+	}
+	plain, err := s.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	t := &Token{}
+	if err := json.Unmarshal(plain, t); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	if !t.valid() {
+		return nil, fmt.Errorf("invalid token data")
+	}
+
+	return t, nil
+}
+
+func (s *EncryptedFileTokenStore) Save(ctx context.Context, t *Token) error {
+	plain, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token: %w", err)
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	data := s.aead.Seal(nonce, nonce, plain, nil)
+
+	if err := writeFileAtomic(s.Path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return nil
+}
+
+func (s *EncryptedFileTokenStore) Delete(ctx context.Context) error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
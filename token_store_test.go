@@ -0,0 +1,70 @@
+package kinvest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenStores(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	encStore, err := NewEncryptedFileTokenStore(filepath.Join(dir, "token.enc"), []byte("0123456789abcdef0123456789abcdef"))
+	assert.NoError(t, err)
+
+	stores := map[string]TokenStore{
+		"file":      NewFileTokenStore(filepath.Join(dir, "token.yaml")),
+		"memory":    NewMemoryTokenStore(),
+		"encrypted": encStore,
+	}
+
+	token := &Token{
+		TokenType:   "Bearer",
+		AccessToken: "secret-access-token",
+		ExpiresIn:   time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Load(ctx)
+			assert.ErrorIs(t, err, ErrTokenNotFound)
+
+			assert.NoError(t, s.Save(ctx, token))
+			loaded, err := s.Load(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, token.Authorization(), loaded.Authorization())
+			assert.True(t, token.ExpiresIn.Equal(loaded.ExpiresIn))
+
+			assert.NoError(t, s.Delete(ctx))
+			assert.NoError(t, s.Delete(ctx))
+			_, err = s.Load(ctx)
+			assert.ErrorIs(t, err, ErrTokenNotFound)
+		})
+	}
+}
+
+func TestEncryptedFileTokenStore(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token.enc")
+	ctx := context.Background()
+
+	s, err := NewEncryptedFileTokenStore(tokenPath, []byte("0123456789abcdef"))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Save(ctx, &Token{TokenType: "Bearer", AccessToken: "secret-access-token", ExpiresIn: time.Now().Add(time.Hour)}))
+
+	data, err := os.ReadFile(tokenPath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-access-token")
+
+	other, err := NewEncryptedFileTokenStore(tokenPath, []byte("fedcba9876543210"))
+	assert.NoError(t, err)
+	_, err = other.Load(ctx)
+	assert.Error(t, err)
+
+	_, err = NewEncryptedFileTokenStore(tokenPath, []byte("short"))
+	assert.Error(t, err)
+}
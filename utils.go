@@ -1,7 +1,6 @@
 package kinvest
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,28 +12,13 @@ import (
 	"time"
 
 	"github.com/goccy/go-yaml"
+	"github.com/suapapa/go_kinvest/internal/conv"
 )
 
 var (
-	loc = time.FixedZone("KST", 9*60*60)
+	loc = conv.KST
 )
 
-func mustCreateJsonReader(data any) io.ReadCloser {
-	buff := bytes.NewBuffer(nil)
-	if err := json.NewEncoder(buff).Encode(data); err != nil {
-		panic(err)
-	}
-	return io.NopCloser(bytes.NewReader(buff.Bytes()))
-}
-
-func mustUnmarshalJsonBody(body io.Reader) map[string]any {
-	var ret map[string]any
-	if err := json.NewDecoder(body).Decode(&ret); err != nil {
-		panic(err)
-	}
-	return ret
-}
-
 func unmarshalJsonBody(body io.Reader, data any) error {
 	if err := json.NewDecoder(body).Decode(data); err != nil {
 		return fmt.Errorf("failed to unmarshal json body: %w", err)
@@ -67,15 +51,17 @@ func parseAccount(account string) (*string, *int, error) {
 	return &first, &secondInt, nil
 }
 
+// getLocalIPAndMAC returns the local IPv4 address and the MAC address of its interface.
+// The MAC address is empty if no interface with the address has one, as in some containers.
 func getLocalIPAndMAC() (string, string, error) {
 	interfaces, err := net.Interfaces()
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get network interfaces: %w", err)
 	}
 
+	var ifaces []localInterface
 	for _, iface := range interfaces {
-		// Skip loopback interfaces and interfaces without a MAC address
-		if iface.Flags&net.FlagLoopback != 0 || iface.HardwareAddr == nil {
+		if iface.Flags&net.FlagLoopback != 0 {
 			continue
 		}
 
@@ -83,15 +69,40 @@ func getLocalIPAndMAC() (string, string, error) {
 		if err != nil {
 			return "", "", fmt.Errorf("failed to get addresses for interface %s: %w", iface.Name, err)
 		}
+		ifaces = append(ifaces, localInterface{mac: iface.HardwareAddr, addrs: addrs})
+	}
 
-		for _, addr := range addrs {
+	return pickLocalIPAndMAC(ifaces)
+}
+
+// localInterface is a non-loopback network interface.
+type localInterface struct {
+	mac   net.HardwareAddr
+	addrs []net.Addr
+}
+
+// pickLocalIPAndMAC prefers the interface with a MAC address,
+// but falls back to the IP of the interface without one.
+func pickLocalIPAndMAC(ifaces []localInterface) (string, string, error) {
+	var fallbackIP string
+	for _, iface := range ifaces {
+		for _, addr := range iface.addrs {
 			ipNet, ok := addr.(*net.IPNet)
-			if ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
-				return ipNet.IP.String(), iface.HardwareAddr.String(), nil
+			if !ok || ipNet.IP.IsLoopback() || ipNet.IP.To4() == nil {
+				continue
+			}
+			if len(iface.mac) > 0 {
+				return ipNet.IP.String(), iface.mac.String(), nil
+			}
+			if fallbackIP == "" {
+				fallbackIP = ipNet.IP.String()
 			}
 		}
 	}
 
+	if fallbackIP != "" {
+		return fallbackIP, "", nil
+	}
 	return "", "", fmt.Errorf("no valid network interface found")
 }
 
@@ -118,14 +129,7 @@ func toStr[T any](v T) string {
 func toInt[T any](v T) int {
 	switch val := any(v).(type) {
 	case string:
-		if val == "" {
-			return 0
-		}
-		i, err := strconv.Atoi(val)
-		if err != nil {
-			return 0
-		}
-		return i
+		return conv.ToInt(val)
 	case int:
 		return val
 	case float64:
@@ -135,17 +139,11 @@ func toInt[T any](v T) int {
 	}
 }
 
+// toFloat converts v to float64. It returns 0 for an empty or malformed string.
 func toFloat[T any](v T) float64 {
 	switch val := any(v).(type) {
 	case string:
-		if val == "" {
-			return 0
-		}
-		f, err := strconv.ParseFloat(val, 64)
-		if err != nil {
-			return 0
-		}
-		return f
+		return conv.ToFloat(val)
 	case float64:
 		return val
 	case int:
@@ -155,6 +153,8 @@ func toFloat[T any](v T) float64 {
 	}
 }
 
+// toTime converts a unix time or a YYYYMMDD string to time.Time.
+// It returns the zero time for an empty or malformed string.
 func toTime[T any](v T) time.Time {
 	switch val := any(v).(type) {
 	case int64:
@@ -179,21 +179,6 @@ func toTime[T any](v T) time.Time {
 	}
 }
 
-func hhmmssToTime(hms string) (time.Time, error) {
-	now := time.Now().In(loc)
-
-	t, err := time.Parse("150405", hms)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
-	}
-
-	return time.Date(
-		now.Year(), now.Month(), now.Day(),
-		t.Hour(), t.Minute(), t.Second(), 0,
-		loc,
-	), nil
-}
-
 func fileExists(filename string) bool {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
 		return false
@@ -212,3 +197,62 @@ func getExt(filename string) string {
 func ptr[T any](v T) *T {
 	return &v
 }
+
+// toInt64 parses s as an integer. It returns 0 for an empty or malformed s.
+func toInt64(s string) int64 {
+	if s == "" {
+		return 0
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		// 일부 필드는 "1234.00" 처럼 소수점이 붙어 온다
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0
+		}
+		return int64(f)
+	}
+	return i
+}
+
+// yymmToTime parses a YYYYMM string. It returns the zero time for a malformed one.
+func yymmToTime(yymm string) time.Time {
+	t, err := time.Parse("200601", yymm)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// writeFileAtomic writes data to a temp file in the same directory and renames it to filename.
+func writeFileAtomic(filename string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer os.Remove(tmpName) // rename 에 성공하면 아무것도 하지 않음
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpName, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, filename)
+}
+
+// truncateDate returns the start of the day of t in KST.
+func truncateDate(t time.Time) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
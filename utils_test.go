@@ -1,6 +1,7 @@
 package kinvest
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,3 +23,36 @@ func TestGetExt(t *testing.T) {
 	ext := getExt(filename)
 	assert.Equal(t, "txt", ext, "extension should be txt")
 }
+
+func TestPickLocalIPAndMAC(t *testing.T) {
+	ipNet := func(s string) net.Addr {
+		return &net.IPNet{IP: net.ParseIP(s), Mask: net.CIDRMask(24, 32)}
+	}
+	mac, _ := net.ParseMAC("00:00:5e:00:53:01")
+
+	// MAC 이 없는 컨테이너에서도 IP 는 찾는다
+	ip, m, err := pickLocalIPAndMAC([]localInterface{{addrs: []net.Addr{ipNet("172.17.0.2")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "172.17.0.2", ip)
+	assert.Empty(t, m)
+
+	// MAC 이 있는 인터페이스를 먼저 쓴다
+	ip, m, err = pickLocalIPAndMAC([]localInterface{
+		{addrs: []net.Addr{ipNet("172.17.0.2")}},
+		{mac: mac, addrs: []net.Addr{ipNet("fe80::1"), ipNet("192.168.0.10")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.0.10", ip)
+	assert.Equal(t, "00:00:5e:00:53:01", m)
+
+	_, _, err = pickLocalIPAndMAC([]localInterface{{mac: mac, addrs: []net.Addr{ipNet("fe80::1")}}})
+	assert.Error(t, err)
+}
+
+func TestToInt64(t *testing.T) {
+	assert.Equal(t, int64(71900), toInt64("71900"))
+	assert.Equal(t, int64(-100), toInt64("-100"))
+	assert.Equal(t, int64(1234), toInt64("1234.00"))
+	assert.Equal(t, int64(0), toInt64(""))
+	assert.Equal(t, int64(0), toInt64("N/A"))
+}